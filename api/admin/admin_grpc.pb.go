@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/admin/admin.proto
+
+package admin_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PinderAdmin_FindUser_FullMethodName         = "/pinder.admin.PinderAdmin/FindUser"
+	PinderAdmin_GetUserProfile_FullMethodName   = "/pinder.admin.PinderAdmin/GetUserProfile"
+	PinderAdmin_ListPairAttempts_FullMethodName = "/pinder.admin.PinderAdmin/ListPairAttempts"
+	PinderAdmin_ListChats_FullMethodName        = "/pinder.admin.PinderAdmin/ListChats"
+	PinderAdmin_BanUser_FullMethodName          = "/pinder.admin.PinderAdmin/BanUser"
+	PinderAdmin_UnbanUser_FullMethodName        = "/pinder.admin.PinderAdmin/UnbanUser"
+	PinderAdmin_ForceLogout_FullMethodName      = "/pinder.admin.PinderAdmin/ForceLogout"
+	PinderAdmin_TakeDownPhoto_FullMethodName    = "/pinder.admin.PinderAdmin/TakeDownPhoto"
+	PinderAdmin_ListAuditRecords_FullMethodName = "/pinder.admin.PinderAdmin/ListAuditRecords"
+	PinderAdmin_ExportAuditLog_FullMethodName   = "/pinder.admin.PinderAdmin/ExportAuditLog"
+)
+
+// PinderAdminClient is the client API for PinderAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PinderAdminClient interface {
+	FindUser(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error)
+	GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error)
+	ListPairAttempts(ctx context.Context, in *ListPairAttemptsRequest, opts ...grpc.CallOption) (*ListPairAttemptsResponse, error)
+	ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TakeDownPhoto(ctx context.Context, in *TakeDownPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (*ExportAuditLogResponse, error)
+}
+
+type pinderAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPinderAdminClient(cc grpc.ClientConnInterface) PinderAdminClient {
+	return &pinderAdminClient{cc}
+}
+
+func (c *pinderAdminClient) FindUser(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindUserResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_FindUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) GetUserProfile(ctx context.Context, in *GetUserProfileRequest, opts ...grpc.CallOption) (*GetUserProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserProfileResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_GetUserProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) ListPairAttempts(ctx context.Context, in *ListPairAttemptsRequest, opts ...grpc.CallOption) (*ListPairAttemptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPairAttemptsResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_ListPairAttempts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChatsResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_ListChats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderAdmin_BanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderAdmin_UnbanUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) ForceLogout(ctx context.Context, in *ForceLogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderAdmin_ForceLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) TakeDownPhoto(ctx context.Context, in *TakeDownPhotoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderAdmin_TakeDownPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_ListAuditRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAdminClient) ExportAuditLog(ctx context.Context, in *ExportAuditLogRequest, opts ...grpc.CallOption) (*ExportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuditLogResponse)
+	err := c.cc.Invoke(ctx, PinderAdmin_ExportAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PinderAdminServer is the server API for PinderAdmin service.
+// All implementations must embed UnimplementedPinderAdminServer
+// for forward compatibility.
+type PinderAdminServer interface {
+	FindUser(context.Context, *FindUserRequest) (*FindUserResponse, error)
+	GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error)
+	ListPairAttempts(context.Context, *ListPairAttemptsRequest) (*ListPairAttemptsResponse, error)
+	ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error)
+	BanUser(context.Context, *BanUserRequest) (*emptypb.Empty, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error)
+	ForceLogout(context.Context, *ForceLogoutRequest) (*emptypb.Empty, error)
+	TakeDownPhoto(context.Context, *TakeDownPhotoRequest) (*emptypb.Empty, error)
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	ExportAuditLog(context.Context, *ExportAuditLogRequest) (*ExportAuditLogResponse, error)
+	mustEmbedUnimplementedPinderAdminServer()
+}
+
+// UnimplementedPinderAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPinderAdminServer struct{}
+
+func (UnimplementedPinderAdminServer) FindUser(context.Context, *FindUserRequest) (*FindUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUser not implemented")
+}
+func (UnimplementedPinderAdminServer) GetUserProfile(context.Context, *GetUserProfileRequest) (*GetUserProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserProfile not implemented")
+}
+func (UnimplementedPinderAdminServer) ListPairAttempts(context.Context, *ListPairAttemptsRequest) (*ListPairAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPairAttempts not implemented")
+}
+func (UnimplementedPinderAdminServer) ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChats not implemented")
+}
+func (UnimplementedPinderAdminServer) BanUser(context.Context, *BanUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanUser not implemented")
+}
+func (UnimplementedPinderAdminServer) UnbanUser(context.Context, *UnbanUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanUser not implemented")
+}
+func (UnimplementedPinderAdminServer) ForceLogout(context.Context, *ForceLogoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceLogout not implemented")
+}
+func (UnimplementedPinderAdminServer) TakeDownPhoto(context.Context, *TakeDownPhotoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeDownPhoto not implemented")
+}
+func (UnimplementedPinderAdminServer) ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (UnimplementedPinderAdminServer) ExportAuditLog(context.Context, *ExportAuditLogRequest) (*ExportAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditLog not implemented")
+}
+func (UnimplementedPinderAdminServer) mustEmbedUnimplementedPinderAdminServer() {}
+func (UnimplementedPinderAdminServer) testEmbeddedByValue()                     {}
+
+// UnsafePinderAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PinderAdminServer will
+// result in compilation errors.
+type UnsafePinderAdminServer interface {
+	mustEmbedUnimplementedPinderAdminServer()
+}
+
+func RegisterPinderAdminServer(s grpc.ServiceRegistrar, srv PinderAdminServer) {
+	// If the following call pancis, it indicates UnimplementedPinderAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PinderAdmin_ServiceDesc, srv)
+}
+
+func _PinderAdmin_FindUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).FindUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_FindUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).FindUser(ctx, req.(*FindUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_GetUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).GetUserProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_GetUserProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).GetUserProfile(ctx, req.(*GetUserProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_ListPairAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPairAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).ListPairAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_ListPairAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).ListPairAttempts(ctx, req.(*ListPairAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_ListChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).ListChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_ListChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).ListChats(ctx, req.(*ListChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_BanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_UnbanUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_ForceLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceLogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).ForceLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_ForceLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).ForceLogout(ctx, req.(*ForceLogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_TakeDownPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeDownPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).TakeDownPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_TakeDownPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).TakeDownPhoto(ctx, req.(*TakeDownPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_ListAuditRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderAdmin_ExportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAdminServer).ExportAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderAdmin_ExportAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAdminServer).ExportAuditLog(ctx, req.(*ExportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PinderAdmin_ServiceDesc is the grpc.ServiceDesc for PinderAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PinderAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pinder.admin.PinderAdmin",
+	HandlerType: (*PinderAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindUser",
+			Handler:    _PinderAdmin_FindUser_Handler,
+		},
+		{
+			MethodName: "GetUserProfile",
+			Handler:    _PinderAdmin_GetUserProfile_Handler,
+		},
+		{
+			MethodName: "ListPairAttempts",
+			Handler:    _PinderAdmin_ListPairAttempts_Handler,
+		},
+		{
+			MethodName: "ListChats",
+			Handler:    _PinderAdmin_ListChats_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _PinderAdmin_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _PinderAdmin_UnbanUser_Handler,
+		},
+		{
+			MethodName: "ForceLogout",
+			Handler:    _PinderAdmin_ForceLogout_Handler,
+		},
+		{
+			MethodName: "TakeDownPhoto",
+			Handler:    _PinderAdmin_TakeDownPhoto_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _PinderAdmin_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "ExportAuditLog",
+			Handler:    _PinderAdmin_ExportAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/admin/admin.proto",
+}
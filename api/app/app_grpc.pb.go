@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/app/app.proto
+
+package app_api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PinderApp_GetOnboardingStatus_FullMethodName  = "/pinder.app.PinderApp/GetOnboardingStatus"
+	PinderApp_SetIncognito_FullMethodName         = "/pinder.app.PinderApp/SetIncognito"
+	PinderApp_SetBirthdate_FullMethodName         = "/pinder.app.PinderApp/SetBirthdate"
+	PinderApp_UpdateProfileDetails_FullMethodName = "/pinder.app.PinderApp/UpdateProfileDetails"
+	PinderApp_GetProfileViewStats_FullMethodName  = "/pinder.app.PinderApp/GetProfileViewStats"
+	PinderApp_GetRecentViewers_FullMethodName     = "/pinder.app.PinderApp/GetRecentViewers"
+	PinderApp_SetShowViewers_FullMethodName       = "/pinder.app.PinderApp/SetShowViewers"
+	PinderApp_GetPreferences_FullMethodName       = "/pinder.app.PinderApp/GetPreferences"
+	PinderApp_UpdatePreferences_FullMethodName    = "/pinder.app.PinderApp/UpdatePreferences"
+	PinderApp_GetTravelMode_FullMethodName        = "/pinder.app.PinderApp/GetTravelMode"
+	PinderApp_StartTravelMode_FullMethodName      = "/pinder.app.PinderApp/StartTravelMode"
+	PinderApp_StopTravelMode_FullMethodName       = "/pinder.app.PinderApp/StopTravelMode"
+	PinderApp_NextPartner_FullMethodName          = "/pinder.app.PinderApp/NextPartner"
+	PinderApp_Boost_FullMethodName                = "/pinder.app.PinderApp/Boost"
+	PinderApp_ListChats_FullMethodName            = "/pinder.app.PinderApp/ListChats"
+	PinderApp_ListMessages_FullMethodName         = "/pinder.app.PinderApp/ListMessages"
+	PinderApp_GetMessageEdits_FullMethodName      = "/pinder.app.PinderApp/GetMessageEdits"
+	PinderApp_SearchMessages_FullMethodName       = "/pinder.app.PinderApp/SearchMessages"
+)
+
+// PinderAppClient is the client API for PinderApp service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PinderAppClient interface {
+	GetOnboardingStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetOnboardingStatusResponse, error)
+	SetIncognito(ctx context.Context, in *SetIncognitoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetBirthdate(ctx context.Context, in *SetBirthdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateProfileDetails(ctx context.Context, in *UpdateProfileDetailsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetProfileViewStats(ctx context.Context, in *GetProfileViewStatsRequest, opts ...grpc.CallOption) (*GetProfileViewStatsResponse, error)
+	GetRecentViewers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRecentViewersResponse, error)
+	SetShowViewers(ctx context.Context, in *SetShowViewersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTravelMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTravelModeResponse, error)
+	StartTravelMode(ctx context.Context, in *StartTravelModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StopTravelMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	NextPartner(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NextPartnerResponse, error)
+	Boost(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoostResponse, error)
+	ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error)
+	ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error)
+	GetMessageEdits(ctx context.Context, in *GetMessageEditsRequest, opts ...grpc.CallOption) (*GetMessageEditsResponse, error)
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+}
+
+type pinderAppClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPinderAppClient(cc grpc.ClientConnInterface) PinderAppClient {
+	return &pinderAppClient{cc}
+}
+
+func (c *pinderAppClient) GetOnboardingStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetOnboardingStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOnboardingStatusResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetOnboardingStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) SetIncognito(ctx context.Context, in *SetIncognitoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_SetIncognito_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) SetBirthdate(ctx context.Context, in *SetBirthdateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_SetBirthdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) UpdateProfileDetails(ctx context.Context, in *UpdateProfileDetailsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_UpdateProfileDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) GetProfileViewStats(ctx context.Context, in *GetProfileViewStatsRequest, opts ...grpc.CallOption) (*GetProfileViewStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileViewStatsResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetProfileViewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) GetRecentViewers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRecentViewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentViewersResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetRecentViewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) SetShowViewers(ctx context.Context, in *SetShowViewersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_SetShowViewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) GetPreferences(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) GetTravelMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTravelModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTravelModeResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetTravelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) StartTravelMode(ctx context.Context, in *StartTravelModeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_StartTravelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) StopTravelMode(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PinderApp_StopTravelMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) NextPartner(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NextPartnerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextPartnerResponse)
+	err := c.cc.Invoke(ctx, PinderApp_NextPartner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) Boost(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*BoostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoostResponse)
+	err := c.cc.Invoke(ctx, PinderApp_Boost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) ListChats(ctx context.Context, in *ListChatsRequest, opts ...grpc.CallOption) (*ListChatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChatsResponse)
+	err := c.cc.Invoke(ctx, PinderApp_ListChats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) ListMessages(ctx context.Context, in *ListMessagesRequest, opts ...grpc.CallOption) (*ListMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessagesResponse)
+	err := c.cc.Invoke(ctx, PinderApp_ListMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) GetMessageEdits(ctx context.Context, in *GetMessageEditsRequest, opts ...grpc.CallOption) (*GetMessageEditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMessageEditsResponse)
+	err := c.cc.Invoke(ctx, PinderApp_GetMessageEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pinderAppClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, PinderApp_SearchMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PinderAppServer is the server API for PinderApp service.
+// All implementations must embed UnimplementedPinderAppServer
+// for forward compatibility.
+type PinderAppServer interface {
+	GetOnboardingStatus(context.Context, *emptypb.Empty) (*GetOnboardingStatusResponse, error)
+	SetIncognito(context.Context, *SetIncognitoRequest) (*emptypb.Empty, error)
+	SetBirthdate(context.Context, *SetBirthdateRequest) (*emptypb.Empty, error)
+	UpdateProfileDetails(context.Context, *UpdateProfileDetailsRequest) (*emptypb.Empty, error)
+	GetProfileViewStats(context.Context, *GetProfileViewStatsRequest) (*GetProfileViewStatsResponse, error)
+	GetRecentViewers(context.Context, *emptypb.Empty) (*GetRecentViewersResponse, error)
+	SetShowViewers(context.Context, *SetShowViewersRequest) (*emptypb.Empty, error)
+	GetPreferences(context.Context, *emptypb.Empty) (*GetPreferencesResponse, error)
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*emptypb.Empty, error)
+	GetTravelMode(context.Context, *emptypb.Empty) (*GetTravelModeResponse, error)
+	StartTravelMode(context.Context, *StartTravelModeRequest) (*emptypb.Empty, error)
+	StopTravelMode(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	NextPartner(context.Context, *emptypb.Empty) (*NextPartnerResponse, error)
+	Boost(context.Context, *emptypb.Empty) (*BoostResponse, error)
+	ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error)
+	ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error)
+	GetMessageEdits(context.Context, *GetMessageEditsRequest) (*GetMessageEditsResponse, error)
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	mustEmbedUnimplementedPinderAppServer()
+}
+
+// UnimplementedPinderAppServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPinderAppServer struct{}
+
+func (UnimplementedPinderAppServer) GetOnboardingStatus(context.Context, *emptypb.Empty) (*GetOnboardingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOnboardingStatus not implemented")
+}
+func (UnimplementedPinderAppServer) SetIncognito(context.Context, *SetIncognitoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIncognito not implemented")
+}
+func (UnimplementedPinderAppServer) SetBirthdate(context.Context, *SetBirthdateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBirthdate not implemented")
+}
+func (UnimplementedPinderAppServer) UpdateProfileDetails(context.Context, *UpdateProfileDetailsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfileDetails not implemented")
+}
+func (UnimplementedPinderAppServer) GetProfileViewStats(context.Context, *GetProfileViewStatsRequest) (*GetProfileViewStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileViewStats not implemented")
+}
+func (UnimplementedPinderAppServer) GetRecentViewers(context.Context, *emptypb.Empty) (*GetRecentViewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentViewers not implemented")
+}
+func (UnimplementedPinderAppServer) SetShowViewers(context.Context, *SetShowViewersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShowViewers not implemented")
+}
+func (UnimplementedPinderAppServer) GetPreferences(context.Context, *emptypb.Empty) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedPinderAppServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedPinderAppServer) GetTravelMode(context.Context, *emptypb.Empty) (*GetTravelModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTravelMode not implemented")
+}
+func (UnimplementedPinderAppServer) StartTravelMode(context.Context, *StartTravelModeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTravelMode not implemented")
+}
+func (UnimplementedPinderAppServer) StopTravelMode(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopTravelMode not implemented")
+}
+func (UnimplementedPinderAppServer) NextPartner(context.Context, *emptypb.Empty) (*NextPartnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPartner not implemented")
+}
+func (UnimplementedPinderAppServer) Boost(context.Context, *emptypb.Empty) (*BoostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Boost not implemented")
+}
+func (UnimplementedPinderAppServer) ListChats(context.Context, *ListChatsRequest) (*ListChatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChats not implemented")
+}
+func (UnimplementedPinderAppServer) ListMessages(context.Context, *ListMessagesRequest) (*ListMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
+}
+func (UnimplementedPinderAppServer) GetMessageEdits(context.Context, *GetMessageEditsRequest) (*GetMessageEditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageEdits not implemented")
+}
+func (UnimplementedPinderAppServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedPinderAppServer) mustEmbedUnimplementedPinderAppServer() {}
+func (UnimplementedPinderAppServer) testEmbeddedByValue()                   {}
+
+// UnsafePinderAppServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PinderAppServer will
+// result in compilation errors.
+type UnsafePinderAppServer interface {
+	mustEmbedUnimplementedPinderAppServer()
+}
+
+func RegisterPinderAppServer(s grpc.ServiceRegistrar, srv PinderAppServer) {
+	// If the following call pancis, it indicates UnimplementedPinderAppServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PinderApp_ServiceDesc, srv)
+}
+
+func _PinderApp_GetOnboardingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetOnboardingStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetOnboardingStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetOnboardingStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_SetIncognito_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIncognitoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).SetIncognito(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_SetIncognito_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).SetIncognito(ctx, req.(*SetIncognitoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_SetBirthdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBirthdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).SetBirthdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_SetBirthdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).SetBirthdate(ctx, req.(*SetBirthdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_UpdateProfileDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).UpdateProfileDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_UpdateProfileDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).UpdateProfileDetails(ctx, req.(*UpdateProfileDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_GetProfileViewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileViewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetProfileViewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetProfileViewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetProfileViewStats(ctx, req.(*GetProfileViewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_GetRecentViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetRecentViewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetRecentViewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetRecentViewers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_SetShowViewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShowViewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).SetShowViewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_SetShowViewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).SetShowViewers(ctx, req.(*SetShowViewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetPreferences(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_GetTravelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetTravelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetTravelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetTravelMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_StartTravelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTravelModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).StartTravelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_StartTravelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).StartTravelMode(ctx, req.(*StartTravelModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_StopTravelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).StopTravelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_StopTravelMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).StopTravelMode(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_NextPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).NextPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_NextPartner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).NextPartner(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_Boost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).Boost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_Boost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).Boost(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_ListChats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).ListChats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_ListChats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).ListChats(ctx, req.(*ListChatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_ListMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).ListMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_ListMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).ListMessages(ctx, req.(*ListMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_GetMessageEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMessageEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).GetMessageEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_GetMessageEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).GetMessageEdits(ctx, req.(*GetMessageEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PinderApp_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PinderAppServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PinderApp_SearchMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PinderAppServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PinderApp_ServiceDesc is the grpc.ServiceDesc for PinderApp service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PinderApp_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pinder.app.PinderApp",
+	HandlerType: (*PinderAppServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOnboardingStatus",
+			Handler:    _PinderApp_GetOnboardingStatus_Handler,
+		},
+		{
+			MethodName: "SetIncognito",
+			Handler:    _PinderApp_SetIncognito_Handler,
+		},
+		{
+			MethodName: "SetBirthdate",
+			Handler:    _PinderApp_SetBirthdate_Handler,
+		},
+		{
+			MethodName: "UpdateProfileDetails",
+			Handler:    _PinderApp_UpdateProfileDetails_Handler,
+		},
+		{
+			MethodName: "GetProfileViewStats",
+			Handler:    _PinderApp_GetProfileViewStats_Handler,
+		},
+		{
+			MethodName: "GetRecentViewers",
+			Handler:    _PinderApp_GetRecentViewers_Handler,
+		},
+		{
+			MethodName: "SetShowViewers",
+			Handler:    _PinderApp_SetShowViewers_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _PinderApp_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _PinderApp_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "GetTravelMode",
+			Handler:    _PinderApp_GetTravelMode_Handler,
+		},
+		{
+			MethodName: "StartTravelMode",
+			Handler:    _PinderApp_StartTravelMode_Handler,
+		},
+		{
+			MethodName: "StopTravelMode",
+			Handler:    _PinderApp_StopTravelMode_Handler,
+		},
+		{
+			MethodName: "NextPartner",
+			Handler:    _PinderApp_NextPartner_Handler,
+		},
+		{
+			MethodName: "Boost",
+			Handler:    _PinderApp_Boost_Handler,
+		},
+		{
+			MethodName: "ListChats",
+			Handler:    _PinderApp_ListChats_Handler,
+		},
+		{
+			MethodName: "ListMessages",
+			Handler:    _PinderApp_ListMessages_Handler,
+		},
+		{
+			MethodName: "GetMessageEdits",
+			Handler:    _PinderApp_GetMessageEdits_Handler,
+		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _PinderApp_SearchMessages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/app/app.proto",
+}
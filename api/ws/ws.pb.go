@@ -0,0 +1,2111 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: api/ws/ws.proto
+
+package ws_api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ContentType int32
+
+const (
+	ContentType_CONTENT_TYPE_TEXT  ContentType = 0
+	ContentType_CONTENT_TYPE_PHOTO ContentType = 1
+	ContentType_CONTENT_TYPE_VOICE ContentType = 2
+)
+
+// Enum value maps for ContentType.
+var (
+	ContentType_name = map[int32]string{
+		0: "CONTENT_TYPE_TEXT",
+		1: "CONTENT_TYPE_PHOTO",
+		2: "CONTENT_TYPE_VOICE",
+	}
+	ContentType_value = map[string]int32{
+		"CONTENT_TYPE_TEXT":  0,
+		"CONTENT_TYPE_PHOTO": 1,
+		"CONTENT_TYPE_VOICE": 2,
+	}
+)
+
+func (x ContentType) Enum() *ContentType {
+	p := new(ContentType)
+	*p = x
+	return p
+}
+
+func (x ContentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ws_ws_proto_enumTypes[0].Descriptor()
+}
+
+func (ContentType) Type() protoreflect.EnumType {
+	return &file_api_ws_ws_proto_enumTypes[0]
+}
+
+func (x ContentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContentType.Descriptor instead.
+func (ContentType) EnumDescriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{0}
+}
+
+type MessageChangeKind int32
+
+const (
+	MessageChangeKind_MESSAGE_CHANGE_EDITED  MessageChangeKind = 0
+	MessageChangeKind_MESSAGE_CHANGE_DELETED MessageChangeKind = 1
+)
+
+// Enum value maps for MessageChangeKind.
+var (
+	MessageChangeKind_name = map[int32]string{
+		0: "MESSAGE_CHANGE_EDITED",
+		1: "MESSAGE_CHANGE_DELETED",
+	}
+	MessageChangeKind_value = map[string]int32{
+		"MESSAGE_CHANGE_EDITED":  0,
+		"MESSAGE_CHANGE_DELETED": 1,
+	}
+)
+
+func (x MessageChangeKind) Enum() *MessageChangeKind {
+	p := new(MessageChangeKind)
+	*p = x
+	return p
+}
+
+func (x MessageChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ws_ws_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageChangeKind) Type() protoreflect.EnumType {
+	return &file_api_ws_ws_proto_enumTypes[1]
+}
+
+func (x MessageChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageChangeKind.Descriptor instead.
+func (MessageChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{1}
+}
+
+type ClientFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string `protobuf:"bytes,3,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	// Types that are assignable to Command:
+	//	*ClientFrame_TypingStarted
+	//	*ClientFrame_TypingStopped
+	//	*ClientFrame_SendMessage
+	//	*ClientFrame_MarkRead
+	//	*ClientFrame_EditMessage
+	//	*ClientFrame_DeleteMessage
+	//	*ClientFrame_React
+	//	*ClientFrame_Unreact
+	Command isClientFrame_Command `protobuf_oneof:"command"`
+}
+
+func (x *ClientFrame) Reset() {
+	*x = ClientFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientFrame) ProtoMessage() {}
+
+func (x *ClientFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientFrame.ProtoReflect.Descriptor instead.
+func (*ClientFrame) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientFrame) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (m *ClientFrame) GetCommand() isClientFrame_Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetTypingStarted() *TypingStarted {
+	if x, ok := x.GetCommand().(*ClientFrame_TypingStarted); ok {
+		return x.TypingStarted
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetTypingStopped() *TypingStopped {
+	if x, ok := x.GetCommand().(*ClientFrame_TypingStopped); ok {
+		return x.TypingStopped
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetSendMessage() *SendMessage {
+	if x, ok := x.GetCommand().(*ClientFrame_SendMessage); ok {
+		return x.SendMessage
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetMarkRead() *MarkRead {
+	if x, ok := x.GetCommand().(*ClientFrame_MarkRead); ok {
+		return x.MarkRead
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetEditMessage() *EditMessage {
+	if x, ok := x.GetCommand().(*ClientFrame_EditMessage); ok {
+		return x.EditMessage
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetDeleteMessage() *DeleteMessage {
+	if x, ok := x.GetCommand().(*ClientFrame_DeleteMessage); ok {
+		return x.DeleteMessage
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetReact() *React {
+	if x, ok := x.GetCommand().(*ClientFrame_React); ok {
+		return x.React
+	}
+	return nil
+}
+
+func (x *ClientFrame) GetUnreact() *Unreact {
+	if x, ok := x.GetCommand().(*ClientFrame_Unreact); ok {
+		return x.Unreact
+	}
+	return nil
+}
+
+type isClientFrame_Command interface {
+	isClientFrame_Command()
+}
+
+type ClientFrame_TypingStarted struct {
+	TypingStarted *TypingStarted `protobuf:"bytes,1,opt,name=typing_started,json=typingStarted,proto3,oneof"`
+}
+
+type ClientFrame_TypingStopped struct {
+	TypingStopped *TypingStopped `protobuf:"bytes,2,opt,name=typing_stopped,json=typingStopped,proto3,oneof"`
+}
+
+type ClientFrame_SendMessage struct {
+	SendMessage *SendMessage `protobuf:"bytes,4,opt,name=send_message,json=sendMessage,proto3,oneof"`
+}
+
+type ClientFrame_MarkRead struct {
+	MarkRead *MarkRead `protobuf:"bytes,5,opt,name=mark_read,json=markRead,proto3,oneof"`
+}
+
+type ClientFrame_EditMessage struct {
+	EditMessage *EditMessage `protobuf:"bytes,6,opt,name=edit_message,json=editMessage,proto3,oneof"`
+}
+
+type ClientFrame_DeleteMessage struct {
+	DeleteMessage *DeleteMessage `protobuf:"bytes,7,opt,name=delete_message,json=deleteMessage,proto3,oneof"`
+}
+
+type ClientFrame_React struct {
+	React *React `protobuf:"bytes,8,opt,name=react,proto3,oneof"`
+}
+
+type ClientFrame_Unreact struct {
+	Unreact *Unreact `protobuf:"bytes,9,opt,name=unreact,proto3,oneof"`
+}
+
+func (*ClientFrame_TypingStarted) isClientFrame_Command() {}
+
+func (*ClientFrame_TypingStopped) isClientFrame_Command() {}
+
+func (*ClientFrame_SendMessage) isClientFrame_Command() {}
+
+func (*ClientFrame_MarkRead) isClientFrame_Command() {}
+
+func (*ClientFrame_EditMessage) isClientFrame_Command() {}
+
+func (*ClientFrame_DeleteMessage) isClientFrame_Command() {}
+
+func (*ClientFrame_React) isClientFrame_Command() {}
+
+func (*ClientFrame_Unreact) isClientFrame_Command() {}
+
+type TypingStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *TypingStarted) Reset() {
+	*x = TypingStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypingStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingStarted) ProtoMessage() {}
+
+func (x *TypingStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingStarted.ProtoReflect.Descriptor instead.
+func (*TypingStarted) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TypingStarted) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type TypingStopped struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+}
+
+func (x *TypingStopped) Reset() {
+	*x = TypingStopped{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypingStopped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypingStopped) ProtoMessage() {}
+
+func (x *TypingStopped) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypingStopped.ProtoReflect.Descriptor instead.
+func (*TypingStopped) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TypingStopped) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type SendMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId          uint64      `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	ClientMessageId string      `protobuf:"bytes,2,opt,name=client_message_id,json=clientMessageId,proto3" json:"client_message_id,omitempty"`
+	ContentType     ContentType `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=pinder.ws.ContentType" json:"content_type,omitempty"`
+	Payload         []byte      `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	ReplyToId       uint64      `protobuf:"varint,5,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+}
+
+func (x *SendMessage) Reset() {
+	*x = SendMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessage) ProtoMessage() {}
+
+func (x *SendMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessage.ProtoReflect.Descriptor instead.
+func (*SendMessage) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendMessage) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *SendMessage) GetClientMessageId() string {
+	if x != nil {
+		return x.ClientMessageId
+	}
+	return ""
+}
+
+func (x *SendMessage) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_TEXT
+}
+
+func (x *SendMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SendMessage) GetReplyToId() uint64 {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return 0
+}
+
+type MarkRead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId        uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UpToMessageId uint64 `protobuf:"varint,2,opt,name=up_to_message_id,json=upToMessageId,proto3" json:"up_to_message_id,omitempty"`
+}
+
+func (x *MarkRead) Reset() {
+	*x = MarkRead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkRead) ProtoMessage() {}
+
+func (x *MarkRead) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkRead.ProtoReflect.Descriptor instead.
+func (*MarkRead) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkRead) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *MarkRead) GetUpToMessageId() uint64 {
+	if x != nil {
+		return x.UpToMessageId
+	}
+	return 0
+}
+
+type EditMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Text      string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *EditMessage) Reset() {
+	*x = EditMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditMessage) ProtoMessage() {}
+
+func (x *EditMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditMessage.ProtoReflect.Descriptor instead.
+func (*EditMessage) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EditMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *EditMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DeleteMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId   uint64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ForEveryone bool   `protobuf:"varint,2,opt,name=for_everyone,json=forEveryone,proto3" json:"for_everyone,omitempty"`
+}
+
+func (x *DeleteMessage) Reset() {
+	*x = DeleteMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMessage) ProtoMessage() {}
+
+func (x *DeleteMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMessage.ProtoReflect.Descriptor instead.
+func (*DeleteMessage) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteMessage) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *DeleteMessage) GetForEveryone() bool {
+	if x != nil {
+		return x.ForEveryone
+	}
+	return false
+}
+
+type React struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji     string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *React) Reset() {
+	*x = React{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *React) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*React) ProtoMessage() {}
+
+func (x *React) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use React.ProtoReflect.Descriptor instead.
+func (*React) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *React) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *React) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type Unreact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId uint64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+}
+
+func (x *Unreact) Reset() {
+	*x = Unreact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Unreact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unreact) ProtoMessage() {}
+
+func (x *Unreact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unreact.ProtoReflect.Descriptor instead.
+func (*Unreact) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Unreact) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+type ServerFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Frame:
+	//	*ServerFrame_Ack
+	//	*ServerFrame_Notification
+	//	*ServerFrame_Event
+	Frame isServerFrame_Frame `protobuf_oneof:"frame"`
+	Seq   uint64              `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *ServerFrame) Reset() {
+	*x = ServerFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerFrame) ProtoMessage() {}
+
+func (x *ServerFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerFrame.ProtoReflect.Descriptor instead.
+func (*ServerFrame) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *ServerFrame) GetFrame() isServerFrame_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetAck() *Ack {
+	if x, ok := x.GetFrame().(*ServerFrame_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetNotification() []byte {
+	if x, ok := x.GetFrame().(*ServerFrame_Notification); ok {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetEvent() *Event {
+	if x, ok := x.GetFrame().(*ServerFrame_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *ServerFrame) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type isServerFrame_Frame interface {
+	isServerFrame_Frame()
+}
+
+type ServerFrame_Ack struct {
+	Ack *Ack `protobuf:"bytes,1,opt,name=ack,proto3,oneof"`
+}
+
+type ServerFrame_Notification struct {
+	Notification []byte `protobuf:"bytes,2,opt,name=notification,proto3,oneof"`
+}
+
+type ServerFrame_Event struct {
+	Event *Event `protobuf:"bytes,4,opt,name=event,proto3,oneof"`
+}
+
+func (*ServerFrame_Ack) isServerFrame_Frame() {}
+
+func (*ServerFrame_Notification) isServerFrame_Frame() {}
+
+func (*ServerFrame_Event) isServerFrame_Frame() {}
+
+// Event carries the notifications that pinder-api's DataPackage has no
+// room for. Only framed connections receive them.
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*Event_BoostFinished
+	//	*Event_ReadReceipt
+	//	*Event_Typing
+	//	*Event_MessageChanged
+	//	*Event_ReactionChanged
+	//	*Event_MessageReply
+	Event isEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *Event) GetEvent() isEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *Event) GetBoostFinished() *BoostFinished {
+	if x, ok := x.GetEvent().(*Event_BoostFinished); ok {
+		return x.BoostFinished
+	}
+	return nil
+}
+
+func (x *Event) GetReadReceipt() *ReadReceipt {
+	if x, ok := x.GetEvent().(*Event_ReadReceipt); ok {
+		return x.ReadReceipt
+	}
+	return nil
+}
+
+func (x *Event) GetTyping() *Typing {
+	if x, ok := x.GetEvent().(*Event_Typing); ok {
+		return x.Typing
+	}
+	return nil
+}
+
+func (x *Event) GetMessageChanged() *MessageChanged {
+	if x, ok := x.GetEvent().(*Event_MessageChanged); ok {
+		return x.MessageChanged
+	}
+	return nil
+}
+
+func (x *Event) GetReactionChanged() *ReactionChanged {
+	if x, ok := x.GetEvent().(*Event_ReactionChanged); ok {
+		return x.ReactionChanged
+	}
+	return nil
+}
+
+func (x *Event) GetMessageReply() *MessageReply {
+	if x, ok := x.GetEvent().(*Event_MessageReply); ok {
+		return x.MessageReply
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_BoostFinished struct {
+	BoostFinished *BoostFinished `protobuf:"bytes,1,opt,name=boost_finished,json=boostFinished,proto3,oneof"`
+}
+
+type Event_ReadReceipt struct {
+	ReadReceipt *ReadReceipt `protobuf:"bytes,2,opt,name=read_receipt,json=readReceipt,proto3,oneof"`
+}
+
+type Event_Typing struct {
+	Typing *Typing `protobuf:"bytes,3,opt,name=typing,proto3,oneof"`
+}
+
+type Event_MessageChanged struct {
+	MessageChanged *MessageChanged `protobuf:"bytes,4,opt,name=message_changed,json=messageChanged,proto3,oneof"`
+}
+
+type Event_ReactionChanged struct {
+	ReactionChanged *ReactionChanged `protobuf:"bytes,5,opt,name=reaction_changed,json=reactionChanged,proto3,oneof"`
+}
+
+type Event_MessageReply struct {
+	MessageReply *MessageReply `protobuf:"bytes,6,opt,name=message_reply,json=messageReply,proto3,oneof"`
+}
+
+func (*Event_BoostFinished) isEvent_Event() {}
+
+func (*Event_ReadReceipt) isEvent_Event() {}
+
+func (*Event_Typing) isEvent_Event() {}
+
+func (*Event_MessageChanged) isEvent_Event() {}
+
+func (*Event_ReactionChanged) isEvent_Event() {}
+
+func (*Event_MessageReply) isEvent_Event() {}
+
+// UserEvent is how events travel over the notifications exchange.
+type UserEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Event  *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UserEvent) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ReadReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId        uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	UpToMessageId uint64 `protobuf:"varint,2,opt,name=up_to_message_id,json=upToMessageId,proto3" json:"up_to_message_id,omitempty"`
+}
+
+func (x *ReadReceipt) Reset() {
+	*x = ReadReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadReceipt) ProtoMessage() {}
+
+func (x *ReadReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadReceipt.ProtoReflect.Descriptor instead.
+func (*ReadReceipt) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadReceipt) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ReadReceipt) GetUpToMessageId() uint64 {
+	if x != nil {
+		return x.UpToMessageId
+	}
+	return 0
+}
+
+type Typing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Typing bool   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+}
+
+func (x *Typing) Reset() {
+	*x = Typing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Typing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Typing) ProtoMessage() {}
+
+func (x *Typing) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Typing.ProtoReflect.Descriptor instead.
+func (*Typing) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Typing) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *Typing) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+type MessageChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId    uint64            `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId uint64            `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Kind      MessageChangeKind `protobuf:"varint,3,opt,name=kind,proto3,enum=pinder.ws.MessageChangeKind" json:"kind,omitempty"`
+	Text      string            `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *MessageChanged) Reset() {
+	*x = MessageChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageChanged) ProtoMessage() {}
+
+func (x *MessageChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageChanged.ProtoReflect.Descriptor instead.
+func (*MessageChanged) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MessageChanged) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *MessageChanged) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageChanged) GetKind() MessageChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MessageChangeKind_MESSAGE_CHANGE_EDITED
+}
+
+func (x *MessageChanged) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ReactionChanged reports the peer's reaction to a message; an empty
+// emoji means the reaction was removed.
+type ReactionChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId    uint64 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId uint64 `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Emoji     string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *ReactionChanged) Reset() {
+	*x = ReactionChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactionChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionChanged) ProtoMessage() {}
+
+func (x *ReactionChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionChanged.ProtoReflect.Descriptor instead.
+func (*ReactionChanged) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReactionChanged) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ReactionChanged) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ReactionChanged) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type MessagePreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SentByMe    bool                   `protobuf:"varint,2,opt,name=sent_by_me,json=sentByMe,proto3" json:"sent_by_me,omitempty"`
+	ContentType ContentType            `protobuf:"varint,3,opt,name=content_type,json=contentType,proto3,enum=pinder.ws.ContentType" json:"content_type,omitempty"`
+	Preview     string                 `protobuf:"bytes,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Deleted     bool                   `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *MessagePreview) Reset() {
+	*x = MessagePreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagePreview) ProtoMessage() {}
+
+func (x *MessagePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagePreview.ProtoReflect.Descriptor instead.
+func (*MessagePreview) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MessagePreview) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MessagePreview) GetSentByMe() bool {
+	if x != nil {
+		return x.SentByMe
+	}
+	return false
+}
+
+func (x *MessagePreview) GetContentType() ContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return ContentType_CONTENT_TYPE_TEXT
+}
+
+func (x *MessagePreview) GetPreview() string {
+	if x != nil {
+		return x.Preview
+	}
+	return ""
+}
+
+func (x *MessagePreview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MessagePreview) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+// MessageReply follows an incoming message that quotes another one,
+// since pinder-api's IncomingMessageNotification has no field for it.
+type MessageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId    uint64          `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	MessageId uint64          `protobuf:"varint,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ReplyTo   *MessagePreview `protobuf:"bytes,3,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+}
+
+func (x *MessageReply) Reset() {
+	*x = MessageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReply) ProtoMessage() {}
+
+func (x *MessageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReply.ProtoReflect.Descriptor instead.
+func (*MessageReply) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MessageReply) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *MessageReply) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageReply) GetReplyTo() *MessagePreview {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+type BoostFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Views int32 `protobuf:"varint,1,opt,name=views,proto3" json:"views,omitempty"`
+	Likes int32 `protobuf:"varint,2,opt,name=likes,proto3" json:"likes,omitempty"`
+}
+
+func (x *BoostFinished) Reset() {
+	*x = BoostFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoostFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoostFinished) ProtoMessage() {}
+
+func (x *BoostFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoostFinished.ProtoReflect.Descriptor instead.
+func (*BoostFinished) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BoostFinished) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *BoostFinished) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+type Ack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId    string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Ok           bool   `protobuf:"varint,2,opt,name=ok,proto3" json:"ok,omitempty"`
+	MessageId    uint64 `protobuf:"varint,3,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ErrorReason  string `protobuf:"bytes,4,opt,name=error_reason,json=errorReason,proto3" json:"error_reason,omitempty"`
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ws_ws_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ws_ws_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_api_ws_ws_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Ack) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *Ack) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *Ack) GetMessageId() uint64 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *Ack) GetErrorReason() string {
+	if x != nil {
+		return x.ErrorReason
+	}
+	return ""
+}
+
+func (x *Ack) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+var File_api_ws_ws_proto protoreflect.FileDescriptor
+
+var file_api_ws_ws_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x73, 0x2f, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x04,
+	0x0a, 0x0b, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0e,
+	0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73,
+	0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0d, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x41, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x77, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x2e, 0x77, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x32, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x72, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x2e, 0x77, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x61, 0x63, 0x74, 0x12, 0x2e, 0x0a,
+	0x07, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x48, 0x00, 0x52, 0x07, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x42, 0x09, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x28, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74,
+	0x49, 0x64, 0x22, 0x28, 0x0a, 0x0d, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70,
+	0x70, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x22, 0xc7, 0x01, 0x0a,
+	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x77, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x6f, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x08, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x75,
+	0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x5f, 0x65, 0x76,
+	0x65, 0x72, 0x79, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6f,
+	0x72, 0x45, 0x76, 0x65, 0x72, 0x79, 0x6f, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x05, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x28, 0x0a, 0x07, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x64, 0x22, 0x9c, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x48, 0x00,
+	0x52, 0x03, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x22, 0x8c, 0x03, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x62, 0x6f,
+	0x6f, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d,
+	0x62, 0x6f, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x72,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x74, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
+	0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x44, 0x0a, 0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x47, 0x0a,
+	0x10, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x4c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x4f, 0x0a,
+	0x0b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x75, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x75, 0x70, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x39,
+	0x0a, 0x06, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x5f, 0x0a, 0x0f, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0xe8, 0x01, 0x0a, 0x0e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x4d, 0x65, 0x12, 0x39, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x77, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x54, 0x6f, 0x22, 0x3b, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a,
+	0x54, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x54, 0x4f, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x4f,
+	0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x4a, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x44, 0x49,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x61, 0x79, 0x79, 0x65, 0x34, 0x6b, 0x61, 0x2f, 0x70, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x77, 0x73, 0x3b, 0x77, 0x73, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_ws_ws_proto_rawDescOnce sync.Once
+	file_api_ws_ws_proto_rawDescData = file_api_ws_ws_proto_rawDesc
+)
+
+func file_api_ws_ws_proto_rawDescGZIP() []byte {
+	file_api_ws_ws_proto_rawDescOnce.Do(func() {
+		file_api_ws_ws_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_ws_ws_proto_rawDescData)
+	})
+	return file_api_ws_ws_proto_rawDescData
+}
+
+var file_api_ws_ws_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_ws_ws_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_api_ws_ws_proto_goTypes = []any{
+	(ContentType)(0),              // 0: pinder.ws.ContentType
+	(MessageChangeKind)(0),        // 1: pinder.ws.MessageChangeKind
+	(*ClientFrame)(nil),           // 2: pinder.ws.ClientFrame
+	(*TypingStarted)(nil),         // 3: pinder.ws.TypingStarted
+	(*TypingStopped)(nil),         // 4: pinder.ws.TypingStopped
+	(*SendMessage)(nil),           // 5: pinder.ws.SendMessage
+	(*MarkRead)(nil),              // 6: pinder.ws.MarkRead
+	(*EditMessage)(nil),           // 7: pinder.ws.EditMessage
+	(*DeleteMessage)(nil),         // 8: pinder.ws.DeleteMessage
+	(*React)(nil),                 // 9: pinder.ws.React
+	(*Unreact)(nil),               // 10: pinder.ws.Unreact
+	(*ServerFrame)(nil),           // 11: pinder.ws.ServerFrame
+	(*Event)(nil),                 // 12: pinder.ws.Event
+	(*UserEvent)(nil),             // 13: pinder.ws.UserEvent
+	(*ReadReceipt)(nil),           // 14: pinder.ws.ReadReceipt
+	(*Typing)(nil),                // 15: pinder.ws.Typing
+	(*MessageChanged)(nil),        // 16: pinder.ws.MessageChanged
+	(*ReactionChanged)(nil),       // 17: pinder.ws.ReactionChanged
+	(*MessagePreview)(nil),        // 18: pinder.ws.MessagePreview
+	(*MessageReply)(nil),          // 19: pinder.ws.MessageReply
+	(*BoostFinished)(nil),         // 20: pinder.ws.BoostFinished
+	(*Ack)(nil),                   // 21: pinder.ws.Ack
+	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
+}
+var file_api_ws_ws_proto_depIdxs = []int32{
+	3,  // 0: pinder.ws.ClientFrame.typing_started:type_name -> pinder.ws.TypingStarted
+	4,  // 1: pinder.ws.ClientFrame.typing_stopped:type_name -> pinder.ws.TypingStopped
+	5,  // 2: pinder.ws.ClientFrame.send_message:type_name -> pinder.ws.SendMessage
+	6,  // 3: pinder.ws.ClientFrame.mark_read:type_name -> pinder.ws.MarkRead
+	7,  // 4: pinder.ws.ClientFrame.edit_message:type_name -> pinder.ws.EditMessage
+	8,  // 5: pinder.ws.ClientFrame.delete_message:type_name -> pinder.ws.DeleteMessage
+	9,  // 6: pinder.ws.ClientFrame.react:type_name -> pinder.ws.React
+	10, // 7: pinder.ws.ClientFrame.unreact:type_name -> pinder.ws.Unreact
+	0,  // 8: pinder.ws.SendMessage.content_type:type_name -> pinder.ws.ContentType
+	21, // 9: pinder.ws.ServerFrame.ack:type_name -> pinder.ws.Ack
+	12, // 10: pinder.ws.ServerFrame.event:type_name -> pinder.ws.Event
+	20, // 11: pinder.ws.Event.boost_finished:type_name -> pinder.ws.BoostFinished
+	14, // 12: pinder.ws.Event.read_receipt:type_name -> pinder.ws.ReadReceipt
+	15, // 13: pinder.ws.Event.typing:type_name -> pinder.ws.Typing
+	16, // 14: pinder.ws.Event.message_changed:type_name -> pinder.ws.MessageChanged
+	17, // 15: pinder.ws.Event.reaction_changed:type_name -> pinder.ws.ReactionChanged
+	19, // 16: pinder.ws.Event.message_reply:type_name -> pinder.ws.MessageReply
+	12, // 17: pinder.ws.UserEvent.event:type_name -> pinder.ws.Event
+	1,  // 18: pinder.ws.MessageChanged.kind:type_name -> pinder.ws.MessageChangeKind
+	0,  // 19: pinder.ws.MessagePreview.content_type:type_name -> pinder.ws.ContentType
+	22, // 20: pinder.ws.MessagePreview.created_at:type_name -> google.protobuf.Timestamp
+	18, // 21: pinder.ws.MessageReply.reply_to:type_name -> pinder.ws.MessagePreview
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_api_ws_ws_proto_init() }
+func file_api_ws_ws_proto_init() {
+	if File_api_ws_ws_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_ws_ws_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ClientFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TypingStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TypingStopped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SendMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkRead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*EditMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*React); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Unreact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ServerFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*UserEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ReadReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Typing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ReactionChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*MessagePreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*MessageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*BoostFinished); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ws_ws_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Ack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_ws_ws_proto_msgTypes[0].OneofWrappers = []any{
+		(*ClientFrame_TypingStarted)(nil),
+		(*ClientFrame_TypingStopped)(nil),
+		(*ClientFrame_SendMessage)(nil),
+		(*ClientFrame_MarkRead)(nil),
+		(*ClientFrame_EditMessage)(nil),
+		(*ClientFrame_DeleteMessage)(nil),
+		(*ClientFrame_React)(nil),
+		(*ClientFrame_Unreact)(nil),
+	}
+	file_api_ws_ws_proto_msgTypes[9].OneofWrappers = []any{
+		(*ServerFrame_Ack)(nil),
+		(*ServerFrame_Notification)(nil),
+		(*ServerFrame_Event)(nil),
+	}
+	file_api_ws_ws_proto_msgTypes[10].OneofWrappers = []any{
+		(*Event_BoostFinished)(nil),
+		(*Event_ReadReceipt)(nil),
+		(*Event_Typing)(nil),
+		(*Event_MessageChanged)(nil),
+		(*Event_ReactionChanged)(nil),
+		(*Event_MessageReply)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_ws_ws_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_ws_ws_proto_goTypes,
+		DependencyIndexes: file_api_ws_ws_proto_depIdxs,
+		EnumInfos:         file_api_ws_ws_proto_enumTypes,
+		MessageInfos:      file_api_ws_ws_proto_msgTypes,
+	}.Build()
+	File_api_ws_ws_proto = out.File
+	file_api_ws_ws_proto_rawDesc = nil
+	file_api_ws_ws_proto_goTypes = nil
+	file_api_ws_ws_proto_depIdxs = nil
+}
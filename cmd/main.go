@@ -14,14 +14,20 @@ import (
 	"github.com/caarlos0/env"
 	"github.com/joho/godotenv"
 	ntfc_receive "github.com/mayye4ka/pinder/internal/notifications/receive"
+	ntfc_retention "github.com/mayye4ka/pinder/internal/notifications/retention"
 	ntfc_send "github.com/mayye4ka/pinder/internal/notifications/send"
 	repository "github.com/mayye4ka/pinder/internal/repository/db"
 	"github.com/mayye4ka/pinder/internal/repository/file_storage"
+	admin_server "github.com/mayye4ka/pinder/internal/server/admin-server"
 	grpc_server "github.com/mayye4ka/pinder/internal/server/grpc-server"
 	ws_server "github.com/mayye4ka/pinder/internal/server/ws-server"
 	stt_result "github.com/mayye4ka/pinder/internal/stt/result"
 	stt_task "github.com/mayye4ka/pinder/internal/stt/task"
+	"github.com/mayye4ka/pinder/internal/usecase/activity"
+	"github.com/mayye4ka/pinder/internal/usecase/admin"
+	"github.com/mayye4ka/pinder/internal/usecase/audit"
 	"github.com/mayye4ka/pinder/internal/usecase/authenticator"
+	"github.com/mayye4ka/pinder/internal/usecase/boost"
 	"github.com/mayye4ka/pinder/internal/usecase/service"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -51,6 +57,13 @@ type Config struct {
 	RabbitMqDsn    string `env:"RABBIT_MQ_DSN"`
 	GrpcPort       int    `env:"GRPC_PORT"`
 	WsPort         int    `env:"WS_PORT"`
+	AdminGrpcPort  int    `env:"ADMIN_GRPC_PORT"`
+
+	BoostDuration time.Duration `env:"BOOST_DURATION" envDefault:"30m"`
+	BoostCooldown time.Duration `env:"BOOST_COOLDOWN" envDefault:"24h"`
+
+	NotificationsPerUser  int           `env:"NOTIFICATIONS_PER_USER" envDefault:"500"`
+	NotificationRetention time.Duration `env:"NOTIFICATION_RETENTION" envDefault:"168h"`
 }
 
 func getMinio(config Config) (*minio.Client, error) {
@@ -79,7 +92,7 @@ func getDb(config Config) (*gorm.DB, error) {
 		return nil, fmt.Errorf("can't get mysql: %w", err)
 	}
 
-	db, err := gorm.Open(mysql.Open(config.DbDsn), &gorm.Config{})
+	db, err := gorm.Open(mysql.Open(config.DbDsn), repository.GormConfig())
 	if err != nil {
 		return nil, fmt.Errorf("can't get mysql: %w", err)
 	}
@@ -136,26 +149,35 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	ntfcSender, err := ntfc_send.NewNotificationSender(rabbit, &logger)
+	ntfcSender, err := ntfc_send.NewNotificationSender(rabbit, repository, config.NotificationsPerUser, &logger)
 	if err != nil {
 		log.Fatal(err)
 	}
 	ntfcReceiver := ntfc_receive.NewNotificationReceiver(rabbit, &logger)
+	ntfcPruner := ntfc_retention.NewPruner(repository, config.NotificationRetention, &logger)
 
-	auth := authenticator.New(repository, &logger)
-	wsServer := ws_server.NewWsServer(auth, ntfcReceiver, config.WsPort)
-	svc := service.New(repository, fileStorage, ntfcSender, sttTaskCreator)
+	auditor := audit.New(repository, &logger)
+	auth := authenticator.New(repository, auditor, &logger)
+	svc := service.New(repository, fileStorage, ntfcSender, sttTaskCreator, auditor)
+	wsServer := ws_server.NewWsServer(auth, svc, ntfcReceiver, repository, config.WsPort)
 	sttResultReceiver := stt_result.NewResultReceiver(rabbit, svc, &logger)
+	booster := boost.New(repository, ntfcSender, config.BoostDuration, config.BoostCooldown, &logger)
 
-	server := grpc_server.New(svc, auth, config.GrpcPort)
+	activityTracker := activity.New(repository, &logger)
+	server := grpc_server.New(svc, auth, activityTracker, booster, config.GrpcPort)
+	adminSvc := admin.New(repository, fileStorage, auditor)
+	adminServer := admin_server.New(adminSvc, auth, config.AdminGrpcPort)
 
 	eg, egCtx := errgroup.WithContext(ctx)
 
 	for _, s := range []Starter{
 		wsServer,
 		ntfcReceiver,
+		ntfcPruner,
 		sttResultReceiver,
+		booster,
 		server,
+		adminServer,
 	} {
 		eg.Go(func() error {
 			return s.Start(egCtx)
@@ -179,8 +201,11 @@ func main() {
 	for _, s := range []Stopper{
 		wsServer,
 		ntfcReceiver,
+		ntfcPruner,
 		sttResultReceiver,
+		booster,
 		server,
+		adminServer,
 	} {
 		eg.Go(func() error {
 			return s.Stop(stopCtx)
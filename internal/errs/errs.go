@@ -1,19 +1,32 @@
 package errs
 
 import (
+	"context"
+	"database/sql/driver"
 	"errors"
+	"net"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
+const errorDomain = "pinder"
+
 type ErrorCode int
 
 const (
-	CodeInternal         ErrorCode = 1
-	CodePermissionDenied ErrorCode = 2
-	CodeNotFound         ErrorCode = 3
-	CodeInvalidInput     ErrorCode = 4
+	CodeInternal           ErrorCode = 1
+	CodePermissionDenied   ErrorCode = 2
+	CodeNotFound           ErrorCode = 3
+	CodeInvalidInput       ErrorCode = 4
+	CodeUnauthenticated    ErrorCode = 5
+	CodeAlreadyExists      ErrorCode = 6
+	CodeFailedPrecondition ErrorCode = 7
+	CodeResourceExhausted  ErrorCode = 8
+	CodeUnavailable        ErrorCode = 9
+	CodeDeadlineExceeded   ErrorCode = 10
 )
 
 func (ec ErrorCode) toGrpc() codes.Code {
@@ -24,14 +37,66 @@ func (ec ErrorCode) toGrpc() codes.Code {
 		return codes.NotFound
 	case CodeInvalidInput:
 		return codes.InvalidArgument
+	case CodeUnauthenticated:
+		return codes.Unauthenticated
+	case CodeAlreadyExists:
+		return codes.AlreadyExists
+	case CodeFailedPrecondition:
+		return codes.FailedPrecondition
+	case CodeResourceExhausted:
+		return codes.ResourceExhausted
+	case CodeUnavailable:
+		return codes.Unavailable
+	case CodeDeadlineExceeded:
+		return codes.DeadlineExceeded
 	default:
 		return codes.Internal
 	}
 }
 
+func (ec ErrorCode) defaultReason() string {
+	switch ec {
+	case CodePermissionDenied:
+		return ReasonPermissionDenied
+	case CodeNotFound:
+		return ReasonNotFound
+	case CodeInvalidInput:
+		return ReasonInvalidInput
+	case CodeUnauthenticated:
+		return ReasonUnauthenticated
+	case CodeAlreadyExists:
+		return ReasonAlreadyExists
+	case CodeFailedPrecondition:
+		return ReasonFailedPrecondition
+	case CodeResourceExhausted:
+		return ReasonResourceExhausted
+	case CodeUnavailable:
+		return ReasonUnavailable
+	case CodeDeadlineExceeded:
+		return ReasonDeadlineExceeded
+	default:
+		return ReasonInternal
+	}
+}
+
+func CodeFromCause(err error) ErrorCode {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return CodeDeadlineExceeded
+	case errors.Is(err, driver.ErrBadConn), errors.As(err, &netErr):
+		return CodeUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
 type CodableError struct {
-	Code    ErrorCode
-	Message string
+	Code       ErrorCode
+	Message    string
+	Reason     string
+	Metadata   map[string]string
+	Violations []FieldViolation
 }
 
 func (e *CodableError) Error() string {
@@ -39,6 +104,7 @@ func (e *CodableError) Error() string {
 }
 
 func extractCodableErr(err error) *CodableError {
+	cause := err
 	for err != nil {
 		ce, ok := err.(*CodableError)
 		if ok {
@@ -47,13 +113,48 @@ func extractCodableErr(err error) *CodableError {
 		err = errors.Unwrap(err)
 	}
 	return &CodableError{
-		Code: CodeInternal,
+		Code: CodeFromCause(cause),
+	}
+}
+
+func (e *CodableError) reason() string {
+	if e.Reason != "" {
+		return e.Reason
 	}
+	return e.Code.defaultReason()
+}
+
+func ReasonOf(err error) string {
+	return extractCodableErr(err).reason()
+}
+
+func CodeOf(err error) ErrorCode {
+	return extractCodableErr(err).Code
 }
 
 func ToGrpcError(e error) error {
 	msg := e.Error()
 	ce := extractCodableErr(e)
-	code := ce.Code.toGrpc()
-	return status.Error(code, msg)
+	reason := ce.reason()
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   errorDomain,
+		Metadata: ce.Metadata,
+	}}
+	if len(ce.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range ce.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	st := status.New(ce.Code.toGrpc(), msg)
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
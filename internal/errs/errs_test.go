@@ -0,0 +1,96 @@
+package errs
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrsTestSuite struct {
+	suite.Suite
+}
+
+func TestErrs(t *testing.T) {
+	suite.Run(t, new(ErrsTestSuite))
+}
+
+func (s *ErrsTestSuite) TestValidator_NoViolations() {
+	v := NewValidator("bad input")
+	v.Check(true, "name", "missing name")
+
+	s.Nil(v.Err())
+}
+
+func (s *ErrsTestSuite) TestToGrpcError_FieldViolations() {
+	v := NewValidator("bad profile")
+	v.Check(false, "name", "missing name")
+	v.Add("height_cm", "height out of range")
+
+	err := ToGrpcError(errors.Wrap(v.Err(), "can't update profile"))
+
+	st := status.Convert(err)
+	s.Equal(codes.InvalidArgument, st.Code())
+	s.Equal("can't update profile: bad profile: missing name; height out of range", st.Message())
+	s.Require().Len(st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	s.Require().True(ok)
+	s.Equal(ReasonValidationFailed, info.Reason)
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	s.Require().True(ok)
+	s.Equal("name", badRequest.FieldViolations[0].Field)
+	s.Equal("missing name", badRequest.FieldViolations[0].Description)
+	s.Equal("height_cm", badRequest.FieldViolations[1].Field)
+	s.Equal("height out of range", badRequest.FieldViolations[1].Description)
+}
+
+func (s *ErrsTestSuite) TestToGrpcError_Internal() {
+	err := ToGrpcError(errors.New("boom"))
+
+	st := status.Convert(err)
+	s.Equal(codes.Internal, st.Code())
+	s.Require().Len(st.Details(), 1)
+	s.Equal(ReasonInternal, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+}
+
+func (s *ErrsTestSuite) TestToGrpcError_ReasonAndMetadata() {
+	err := ToGrpcError(errors.Wrap(&CodableError{
+		Code:     CodeResourceExhausted,
+		Message:  "boost is on cooldown",
+		Reason:   "BOOST_COOLDOWN",
+		Metadata: map[string]string{"available_at": "2024-05-01T12:00:00Z"},
+	}, "can't boost"))
+
+	st := status.Convert(err)
+	s.Equal(codes.ResourceExhausted, st.Code())
+	info := st.Details()[0].(*errdetails.ErrorInfo)
+	s.Equal("BOOST_COOLDOWN", info.Reason)
+	s.Equal("pinder", info.Domain)
+	s.Equal("2024-05-01T12:00:00Z", info.Metadata["available_at"])
+}
+
+func (s *ErrsTestSuite) TestCodeFromCause() {
+	s.Equal(CodeDeadlineExceeded, CodeFromCause(errors.Wrap(context.DeadlineExceeded, "query")))
+	s.Equal(CodeUnavailable, CodeFromCause(driver.ErrBadConn))
+	s.Equal(CodeInternal, CodeFromCause(errors.New("boom")))
+	s.Equal(codes.DeadlineExceeded, status.Code(ToGrpcError(errors.Wrap(context.DeadlineExceeded, "query"))))
+}
+
+func (s *ErrsTestSuite) TestCodeOf() {
+	s.Equal(CodeAlreadyExists, CodeOf(errors.Wrap(&CodableError{Code: CodeAlreadyExists}, "can't send message")))
+	s.Equal(CodeInternal, CodeOf(errors.New("boom")))
+}
+
+func (s *ErrsTestSuite) TestReasonOf() {
+	s.Equal(ReasonChatNotFound, ReasonOf(errors.Wrap(&CodableError{
+		Code:   CodeNotFound,
+		Reason: ReasonChatNotFound,
+	}, "can't get chat")))
+	s.Equal(ReasonPermissionDenied, ReasonOf(&CodableError{Code: CodePermissionDenied}))
+	s.Equal(ReasonInternal, ReasonOf(errors.New("boom")))
+}
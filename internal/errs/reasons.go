@@ -0,0 +1,36 @@
+package errs
+
+const (
+	ReasonInternal           = "INTERNAL"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonNotFound           = "NOT_FOUND"
+	ReasonInvalidInput       = "INVALID_INPUT"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonAlreadyExists      = "ALREADY_EXISTS"
+	ReasonFailedPrecondition = "FAILED_PRECONDITION"
+	ReasonResourceExhausted  = "RESOURCE_EXHAUSTED"
+	ReasonUnavailable        = "UNAVAILABLE"
+	ReasonDeadlineExceeded   = "DEADLINE_EXCEEDED"
+
+	ReasonValidationFailed   = "VALIDATION_FAILED"
+	ReasonInvalidToken       = "INVALID_TOKEN"
+	ReasonTokenRevoked       = "TOKEN_REVOKED"
+	ReasonInvalidCredentials = "INVALID_CREDENTIALS"
+	ReasonUserBanned         = "USER_BANNED"
+	ReasonAdminRequired      = "ADMIN_REQUIRED"
+	ReasonNotChatMember      = "NOT_CHAT_MEMBER"
+	ReasonPhoneTaken         = "PHONE_NUMBER_TAKEN"
+	ReasonUserNotFound       = "USER_NOT_FOUND"
+	ReasonChatNotFound       = "CHAT_NOT_FOUND"
+	ReasonMessageNotFound    = "MESSAGE_NOT_FOUND"
+	ReasonNotMessageSender   = "NOT_MESSAGE_SENDER"
+	ReasonMessageDeleted     = "MESSAGE_DELETED"
+	ReasonEditWindowExpired  = "EDIT_WINDOW_EXPIRED"
+	ReasonPhotoNotFound      = "PHOTO_NOT_FOUND"
+	ReasonPairEventNotFound  = "PAIR_EVENT_NOT_FOUND"
+	ReasonIncompleteProfile  = "INCOMPLETE_PROFILE"
+	ReasonNoCandidates       = "NO_CANDIDATES"
+	ReasonViewersDisabled    = "VIEWERS_DISABLED"
+	ReasonBoostCooldown      = "BOOST_COOLDOWN"
+	ReasonSelfModeration     = "SELF_MODERATION"
+)
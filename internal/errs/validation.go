@@ -0,0 +1,52 @@
+package errs
+
+import "strings"
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+type Validator struct {
+	message    string
+	violations []FieldViolation
+}
+
+func NewValidator(message string) *Validator {
+	return &Validator{message: message}
+}
+
+func (v *Validator) Add(field, description string) {
+	v.violations = append(v.violations, FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+func (v *Validator) Check(ok bool, field, description string) {
+	if !ok {
+		v.Add(field, description)
+	}
+}
+
+func (v *Validator) Err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+	descriptions := make([]string, len(v.violations))
+	for i, violation := range v.violations {
+		descriptions[i] = violation.Description
+	}
+	return &CodableError{
+		Code:       CodeInvalidInput,
+		Reason:     ReasonValidationFailed,
+		Message:    v.message + ": " + strings.Join(descriptions, "; "),
+		Violations: v.violations,
+	}
+}
+
+func InvalidField(message, field, description string) error {
+	v := NewValidator(message)
+	v.Add(field, description)
+	return v.Err()
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+type ActivityBucket string
+
+const (
+	ActivityUnknown  ActivityBucket = ""
+	ActivityOnline   ActivityBucket = "online"
+	ActivityToday    ActivityBucket = "today"
+	ActivityThisWeek ActivityBucket = "this_week"
+	ActivityLongAgo  ActivityBucket = "long_ago"
+)
+
+func NewActivityBucket(lastActive, now time.Time) ActivityBucket {
+	if lastActive.IsZero() {
+		return ActivityUnknown
+	}
+	since := now.Sub(lastActive)
+	switch {
+	case since < 15*time.Minute:
+		return ActivityOnline
+	case since < 24*time.Hour:
+		return ActivityToday
+	case since < 7*24*time.Hour:
+		return ActivityThisWeek
+	default:
+		return ActivityLongAgo
+	}
+}
+
+func RoundDistanceKm(km float64) int {
+	switch {
+	case km <= 1:
+		return 1
+	case km < 10:
+		return int(math.Ceil(km))
+	case km < 100:
+		return int(math.Round(km/5)) * 5
+	default:
+		return int(math.Round(km/10)) * 10
+	}
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+const MinAge = 18
+
+func (p Profile) AgeAt(now time.Time) int {
+	if p.Birthdate.IsZero() {
+		return 0
+	}
+	now = now.In(p.localZone())
+	age := now.Year() - p.Birthdate.Year()
+	if now.Month() < p.Birthdate.Month() ||
+		now.Month() == p.Birthdate.Month() && now.Day() < p.Birthdate.Day() {
+		age--
+	}
+	return age
+}
+
+func (p Profile) localZone() *time.Location {
+	offsetHours := int(math.Round(p.LocationLon / 15))
+	return time.FixedZone("", offsetHours*60*60)
+}
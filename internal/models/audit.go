@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionRegister          AuditAction = "register"
+	AuditActionLogin             AuditAction = "login"
+	AuditActionLoginFailed       AuditAction = "login_failed"
+	AuditActionUpdateProfile     AuditAction = "update_profile"
+	AuditActionUpdatePreferences AuditAction = "update_preferences"
+	AuditActionAddPhoto          AuditAction = "add_photo"
+	AuditActionDeletePhoto       AuditAction = "delete_photo"
+
+	AuditActionLookupUser     AuditAction = "lookup_user"
+	AuditActionViewProfile    AuditAction = "view_profile"
+	AuditActionViewPairs      AuditAction = "view_pair_attempts"
+	AuditActionViewChats      AuditAction = "view_chats"
+	AuditActionViewAuditLog   AuditAction = "view_audit_log"
+	AuditActionExportAuditLog AuditAction = "export_audit_log"
+	AuditActionBanUser        AuditAction = "ban_user"
+	AuditActionUnbanUser      AuditAction = "unban_user"
+	AuditActionForceLogout    AuditAction = "force_logout"
+	AuditActionTakeDownPhoto  AuditAction = "take_down_photo"
+)
+
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+}
+
+type AuditRecord struct {
+	ID        uint64
+	ActorID   uint64
+	Action    AuditAction
+	TargetID  uint64
+	Details   string
+	Meta      RequestMeta
+	CreatedAt time.Time
+}
+
+type AuditFilter struct {
+	ActorID  uint64
+	TargetID uint64
+	Action   AuditAction
+	From     time.Time
+	To       time.Time
+	AfterID  uint64
+	Limit    int
+}
+
+type AuditExport struct {
+	Data        []byte
+	NextAfterID uint64
+}
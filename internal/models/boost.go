@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type Boost struct {
+	ID        uint64
+	UserID    uint64
+	StartedAt time.Time
+	EndsAt    time.Time
+	Reported  bool
+	Result    BoostResult
+}
+
+type BoostResult struct {
+	During   ProfileStats
+	Baseline ProfileStats
+}
+
+type BoostFinishedNotification struct {
+	Views int
+	Likes int
+}
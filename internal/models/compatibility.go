@@ -0,0 +1,67 @@
+package models
+
+import (
+	"math"
+
+	"github.com/jftuga/geodist"
+)
+
+const (
+	compatibilityInterestsWeight = 40
+	compatibilityLanguagesWeight = 20
+	compatibilityGoalWeight      = 20
+	compatibilityDistanceWeight  = 20
+	compatibilityDistanceKm      = 100
+)
+
+type Compatibility struct {
+	Score           int
+	SharedInterests []string
+	SharedLanguages []string
+	GoalsAligned    bool
+}
+
+func NewCompatibility(me, other Profile) Compatibility {
+	c := Compatibility{
+		SharedInterests: intersect(me.Interests, other.Interests),
+		SharedLanguages: intersect(me.Languages, other.Languages),
+		GoalsAligned:    me.RelationshipGoal != "" && me.RelationshipGoal == other.RelationshipGoal,
+	}
+	score := 0.0
+	if fewer := min(len(me.Interests), len(other.Interests)); fewer > 0 {
+		score += compatibilityInterestsWeight * float64(len(c.SharedInterests)) / float64(fewer)
+	}
+	if len(c.SharedLanguages) > 0 {
+		score += compatibilityLanguagesWeight
+	}
+	if c.GoalsAligned {
+		score += compatibilityGoalWeight
+	}
+	dst, err := me.DistanceKm(other)
+	if err == nil {
+		score += compatibilityDistanceWeight * math.Max(0, 1-dst/compatibilityDistanceKm)
+	}
+	c.Score = int(math.Round(score))
+	return c
+}
+
+func (p Profile) DistanceKm(other Profile) (float64, error) {
+	_, dst, err := geodist.VincentyDistance(geodist.Coord{
+		Lat: p.LocationLat,
+		Lon: p.LocationLon,
+	}, geodist.Coord{
+		Lat: other.LocationLat,
+		Lon: other.LocationLon,
+	})
+	return dst, err
+}
+
+func intersect[T comparable](a, b []T) []T {
+	var res []T
+	for _, v := range a {
+		if contains(b, v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
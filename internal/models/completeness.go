@@ -0,0 +1,80 @@
+package models
+
+type CompletenessItem string
+
+const (
+	CompletenessName             CompletenessItem = "name"
+	CompletenessGender           CompletenessItem = "gender"
+	CompletenessBirthdate        CompletenessItem = "birthdate"
+	CompletenessLocation         CompletenessItem = "location"
+	CompletenessPhoto            CompletenessItem = "photo"
+	CompletenessPreferences      CompletenessItem = "preferences"
+	CompletenessBio              CompletenessItem = "bio"
+	CompletenessMorePhotos       CompletenessItem = "more_photos"
+	CompletenessInterests        CompletenessItem = "interests"
+	CompletenessHeight           CompletenessItem = "height"
+	CompletenessLanguages        CompletenessItem = "languages"
+	CompletenessRelationshipGoal CompletenessItem = "relationship_goal"
+	CompletenessHabits           CompletenessItem = "habits"
+	CompletenessChildren         CompletenessItem = "children"
+)
+
+const (
+	CompletenessLevels    = 5
+	recommendedPhotoCount = 3
+)
+
+type completenessCheck struct {
+	item     CompletenessItem
+	required bool
+	weight   int
+	done     func(prof Profile, prefs Preferences, photoCount int) bool
+}
+
+var completenessChecks = []completenessCheck{
+	{CompletenessName, true, 10, func(p Profile, _ Preferences, _ int) bool { return p.Name != "" }},
+	{CompletenessGender, true, 10, func(p Profile, _ Preferences, _ int) bool { return p.Gender.Valid() }},
+	{CompletenessBirthdate, true, 10, func(p Profile, _ Preferences, _ int) bool { return !p.Birthdate.IsZero() }},
+	{CompletenessLocation, true, 10, func(p Profile, _ Preferences, _ int) bool {
+		return p.LocationName != "" && (p.LocationLat != 0 || p.LocationLon != 0)
+	}},
+	{CompletenessPhoto, true, 10, func(_ Profile, _ Preferences, n int) bool { return n > 0 }},
+	{CompletenessPreferences, true, 10, func(_ Profile, p Preferences, _ int) bool { return p.UserID != 0 }},
+	{CompletenessBio, false, 8, func(p Profile, _ Preferences, _ int) bool { return p.Bio != "" }},
+	{CompletenessMorePhotos, false, 8, func(_ Profile, _ Preferences, n int) bool { return n >= recommendedPhotoCount }},
+	{CompletenessInterests, false, 8, func(p Profile, _ Preferences, _ int) bool { return len(p.Interests) > 0 }},
+	{CompletenessHeight, false, 4, func(p Profile, _ Preferences, _ int) bool { return p.HeightCm != 0 }},
+	{CompletenessLanguages, false, 4, func(p Profile, _ Preferences, _ int) bool { return len(p.Languages) > 0 }},
+	{CompletenessRelationshipGoal, false, 4, func(p Profile, _ Preferences, _ int) bool { return p.RelationshipGoal != "" }},
+	{CompletenessHabits, false, 2, func(p Profile, _ Preferences, _ int) bool { return p.Smoking != "" && p.Drinking != "" }},
+	{CompletenessChildren, false, 2, func(p Profile, _ Preferences, _ int) bool { return p.Children != "" }},
+}
+
+type Completeness struct {
+	Score           int
+	MissingRequired []CompletenessItem
+	MissingOptional []CompletenessItem
+}
+
+func NewCompleteness(prof Profile, prefs Preferences, photoCount int) Completeness {
+	res := Completeness{}
+	for _, check := range completenessChecks {
+		switch {
+		case check.done(prof, prefs, photoCount):
+			res.Score += check.weight
+		case check.required:
+			res.MissingRequired = append(res.MissingRequired, check.item)
+		default:
+			res.MissingOptional = append(res.MissingOptional, check.item)
+		}
+	}
+	return res
+}
+
+func (c Completeness) Complete() bool {
+	return len(c.MissingRequired) == 0
+}
+
+func (c Completeness) Level() int {
+	return c.Score * (CompletenessLevels - 1) / 100
+}
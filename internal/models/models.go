@@ -17,6 +17,14 @@ const (
 	ContentVoice MsgContentType = "voice"
 )
 
+func (ct MsgContentType) Valid() bool {
+	switch ct {
+	case ContentText, ContentPhoto, ContentVoice:
+		return true
+	}
+	return false
+}
+
 type SwipeVerdict string
 
 const (
@@ -27,10 +35,101 @@ const (
 type Gender string
 
 const (
-	GenderMale   Gender = "male"
-	GenderFemale Gender = "female"
+	GenderMale      Gender = "male"
+	GenderFemale    Gender = "female"
+	GenderNonBinary Gender = "non_binary"
+	GenderCustom    Gender = "custom"
+)
+
+func (g Gender) Valid() bool {
+	switch g {
+	case GenderMale, GenderFemale, GenderNonBinary, GenderCustom:
+		return true
+	}
+	return false
+}
+
+type RelationshipGoal string
+
+const (
+	RelationshipGoalLongTerm   RelationshipGoal = "long_term"
+	RelationshipGoalShortTerm  RelationshipGoal = "short_term"
+	RelationshipGoalFriendship RelationshipGoal = "friendship"
+	RelationshipGoalUndecided  RelationshipGoal = "undecided"
+)
+
+func (g RelationshipGoal) Valid() bool {
+	switch g {
+	case RelationshipGoalLongTerm, RelationshipGoalShortTerm, RelationshipGoalFriendship, RelationshipGoalUndecided:
+		return true
+	}
+	return false
+}
+
+type Habit string
+
+const (
+	HabitNever     Habit = "never"
+	HabitSometimes Habit = "sometimes"
+	HabitOften     Habit = "often"
+)
+
+func (h Habit) Valid() bool {
+	switch h {
+	case HabitNever, HabitSometimes, HabitOften:
+		return true
+	}
+	return false
+}
+
+type ChildrenStatus string
+
+const (
+	ChildrenNone     ChildrenStatus = "none"
+	ChildrenHave     ChildrenStatus = "have"
+	ChildrenWant     ChildrenStatus = "want"
+	ChildrenDontWant ChildrenStatus = "dont_want"
 )
 
+func (c ChildrenStatus) Valid() bool {
+	switch c {
+	case ChildrenNone, ChildrenHave, ChildrenWant, ChildrenDontWant:
+		return true
+	}
+	return false
+}
+
+type PreferenceCriterion string
+
+const (
+	CriterionHeight           PreferenceCriterion = "height"
+	CriterionInterests        PreferenceCriterion = "interests"
+	CriterionLanguages        PreferenceCriterion = "languages"
+	CriterionRelationshipGoal PreferenceCriterion = "relationship_goal"
+	CriterionSmoking          PreferenceCriterion = "smoking"
+	CriterionDrinking         PreferenceCriterion = "drinking"
+	CriterionChildren         PreferenceCriterion = "children"
+)
+
+var PreferenceCriteria = []PreferenceCriterion{
+	CriterionHeight,
+	CriterionInterests,
+	CriterionLanguages,
+	CriterionRelationshipGoal,
+	CriterionSmoking,
+	CriterionDrinking,
+	CriterionChildren,
+}
+
+func (c PreferenceCriterion) Valid() bool {
+	for _, criterion := range PreferenceCriteria {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
 type PAState string
 
 const (
@@ -53,23 +152,58 @@ const (
 	PETypePairCreated       PEType = "pair_created"
 )
 
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
 // basic entities
 
 type User struct {
-	ID          uint64
-	PhoneNumber string
-	PassHash    string
+	ID           uint64
+	PhoneNumber  string
+	PassHash     string
+	Role         UserRole
+	Banned       bool
+	Incognito    bool
+	ShowViewers  bool
+	TokenVersion int
+	LastActiveAt time.Time
 }
 
 type Profile struct {
 	UserID       uint64
 	Name         string
 	Gender       Gender
+	CustomGender string
+	Birthdate    time.Time
 	Age          int
 	Bio          string
 	LocationLat  float64
 	LocationLon  float64
 	LocationName string
+
+	HeightCm         int
+	Interests        []string
+	Languages        []string
+	RelationshipGoal RelationshipGoal
+	Smoking          Habit
+	Drinking         Habit
+	Children         ChildrenStatus
+}
+
+// ProfileDetails are the optional profile fields that pinder-api's
+// Profile has no place for.
+type ProfileDetails struct {
+	HeightCm         int
+	Interests        []string
+	Languages        []string
+	RelationshipGoal RelationshipGoal
+	Smoking          Habit
+	Drinking         Habit
+	Children         ChildrenStatus
 }
 
 type Photo struct {
@@ -81,10 +215,20 @@ type Preferences struct {
 	UserID           uint64
 	MaxAge           int
 	MinAge           int
-	Gender           Gender
+	Genders          []Gender
 	LocationLat      float64
 	LocationLon      float64
 	LocationRadiusKm float64
+
+	MinHeightCm       int
+	MaxHeightCm       int
+	Interests         []string
+	Languages         []string
+	RelationshipGoals []RelationshipGoal
+	Smoking           []Habit
+	Drinking          []Habit
+	Children          []ChildrenStatus
+	Dealbreakers      []PreferenceCriterion
 }
 
 type PairAttempt struct {
@@ -103,9 +247,10 @@ type PairEvent struct {
 }
 
 type Chat struct {
-	ID    uint64
-	User1 uint64
-	User2 uint64
+	ID             uint64
+	User1          uint64
+	User2          uint64
+	LastActivityAt time.Time
 }
 
 type Message struct {
@@ -114,7 +259,24 @@ type Message struct {
 	SenderID    uint64
 	ContentType MsgContentType
 	Payload     string
+	ReplyToID   uint64
 	CreatedAt   time.Time
+	EditedAt    time.Time
+	DeletedAt   time.Time
+}
+
+type MessageEdit struct {
+	MessageID uint64
+	Payload   string
+	EditedAt  time.Time
+}
+
+type OutgoingMessage struct {
+	ChatID          uint64
+	ContentType     MsgContentType
+	Payload         string
+	ClientMessageID string
+	ReplyToID       uint64
 }
 
 type MessageTranscription struct {
@@ -122,6 +284,17 @@ type MessageTranscription struct {
 	Transcription string
 }
 
+type ChatActivity struct {
+	MessageCount  int
+	LastMessageAt time.Time
+}
+
+type ChatSummary struct {
+	Chat        Chat
+	LastMessage *Message
+	UnreadCount int
+}
+
 // showcases
 
 type PhotoShowcase struct {
@@ -135,21 +308,54 @@ type MessageShowcase struct {
 	ContentType MsgContentType
 	Payload     string
 	CreatedAt   time.Time
+	Read        bool
+	Edited      bool
+	Deleted     bool
+	Reactions   []ReactionShowcase
+	ReplyTo     *MessagePreview
+}
+
+type MessagePreview struct {
+	ID          uint64
+	SentByMe    bool
+	ContentType MsgContentType
+	Preview     string
+	CreatedAt   time.Time
+	Deleted     bool
 }
 
 type ChatShowcase struct {
-	ID    uint64
-	Name  string
-	Photo string
+	ID             uint64
+	Name           string
+	Photo          string
+	Compatibility  Compatibility
+	DistanceKm     int
+	LastActive     ActivityBucket
+	LastMessage    *MessagePreview
+	UnreadCount    int
+	LastActivityAt time.Time
 }
 
 type ProfileShowcase struct {
-	Profile Profile
-	Photos  []PhotoShowcase
+	Profile       Profile
+	Photos        []PhotoShowcase
+	Compatibility Compatibility
+	DistanceKm    int
+	LastActive    ActivityBucket
+}
+
+type PairAttemptHistory struct {
+	PairAttempt PairAttempt
+	Events      []PairEvent
+}
+
+type ChatInfo struct {
+	Chat     Chat
+	Activity ChatActivity
 }
 
 func (p *Preferences) ProfileMatches(profile Profile) bool {
-	if p.Gender != "" && p.Gender != profile.Gender {
+	if len(p.Genders) > 0 && !contains(p.Genders, profile.Gender) {
 		return false
 	}
 	if p.MinAge != 0 && p.MinAge > profile.Age {
@@ -172,5 +378,111 @@ func (p *Preferences) ProfileMatches(profile Profile) bool {
 	if dst > p.LocationRadiusKm {
 		return false
 	}
+	for _, criterion := range p.Dealbreakers {
+		if p.criterionSet(criterion) && !p.criterionMatches(criterion, profile) {
+			return false
+		}
+	}
 	return true
 }
+
+func (p *Preferences) SoftMatches(profile Profile) bool {
+	for _, criterion := range PreferenceCriteria {
+		if p.IsDealbreaker(criterion) || !p.criterionSet(criterion) {
+			continue
+		}
+		if !p.criterionMatches(criterion, profile) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Preferences) SoftScore(profile Profile) int {
+	score := 0
+	for _, criterion := range PreferenceCriteria {
+		if p.IsDealbreaker(criterion) || !p.criterionSet(criterion) {
+			continue
+		}
+		if p.criterionMatches(criterion, profile) {
+			score++
+		}
+	}
+	return score
+}
+
+func (p *Preferences) IsDealbreaker(criterion PreferenceCriterion) bool {
+	for _, c := range p.Dealbreakers {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Preferences) criterionSet(criterion PreferenceCriterion) bool {
+	switch criterion {
+	case CriterionHeight:
+		return p.MinHeightCm != 0 || p.MaxHeightCm != 0
+	case CriterionInterests:
+		return len(p.Interests) > 0
+	case CriterionLanguages:
+		return len(p.Languages) > 0
+	case CriterionRelationshipGoal:
+		return len(p.RelationshipGoals) > 0
+	case CriterionSmoking:
+		return len(p.Smoking) > 0
+	case CriterionDrinking:
+		return len(p.Drinking) > 0
+	case CriterionChildren:
+		return len(p.Children) > 0
+	}
+	return false
+}
+
+func (p *Preferences) criterionMatches(criterion PreferenceCriterion, profile Profile) bool {
+	switch criterion {
+	case CriterionHeight:
+		if profile.HeightCm == 0 {
+			return false
+		}
+		if p.MinHeightCm != 0 && p.MinHeightCm > profile.HeightCm {
+			return false
+		}
+		if p.MaxHeightCm != 0 && p.MaxHeightCm < profile.HeightCm {
+			return false
+		}
+		return true
+	case CriterionInterests:
+		return overlaps(p.Interests, profile.Interests)
+	case CriterionLanguages:
+		return overlaps(p.Languages, profile.Languages)
+	case CriterionRelationshipGoal:
+		return contains(p.RelationshipGoals, profile.RelationshipGoal)
+	case CriterionSmoking:
+		return contains(p.Smoking, profile.Smoking)
+	case CriterionDrinking:
+		return contains(p.Drinking, profile.Drinking)
+	case CriterionChildren:
+		return contains(p.Children, profile.Children)
+	}
+	return true
+}
+
+func contains[T comparable](list []T, value T) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func overlaps[T comparable](a, b []T) bool {
+	for _, v := range a {
+		if contains(b, v) {
+			return true
+		}
+	}
+	return false
+}
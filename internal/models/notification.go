@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type MatchNotification struct {
 	Name  string
 	Photo string
@@ -16,9 +18,51 @@ type MessageSend struct {
 	SentByMe    bool
 	ContentType MsgContentType
 	Payload     string
+	ReplyTo     *MessagePreview
 }
 
 type MessageTranscibed struct {
 	MessageID uint64
 	Text      string
 }
+
+type ReadReceipt struct {
+	ChatID        uint64
+	UpToMessageID uint64
+}
+
+type TypingNotification struct {
+	ChatID uint64
+	Typing bool
+}
+
+type MessageChangeKind string
+
+const (
+	MessageEdited  MessageChangeKind = "edited"
+	MessageDeleted MessageChangeKind = "deleted"
+)
+
+type MessageChange struct {
+	ChatID    uint64
+	MessageID uint64
+	Kind      MessageChangeKind
+	Payload   string
+}
+
+type NotificationKind string
+
+const (
+	// NotificationData carries a pinder-api DataPackage.
+	NotificationData NotificationKind = "data"
+	// NotificationEvent carries a ws Event, which only framed connections understand.
+	NotificationEvent NotificationKind = "event"
+)
+
+type UserNotification struct {
+	UserID    uint64
+	Seq       uint64
+	Kind      NotificationKind
+	Payload   []byte
+	CreatedAt time.Time
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+type PageDirection string
+
+const (
+	PageOlder PageDirection = "older"
+	PageNewer PageDirection = "newer"
+)
+
+func (d PageDirection) Valid() bool {
+	return d == PageOlder || d == PageNewer
+}
+
+type MessagePage struct {
+	Cursor    uint64
+	Direction PageDirection
+	Limit     int
+}
+
+type MessageList struct {
+	Messages   []MessageShowcase
+	NextCursor uint64
+}
+
+type ChatCursor struct {
+	ActivityAt time.Time
+	ChatID     uint64
+}
+
+func (c ChatCursor) IsZero() bool {
+	return c.ChatID == 0
+}
+
+type ChatPage struct {
+	Cursor ChatCursor
+	Limit  int
+}
+
+type ChatList struct {
+	Chats      []ChatShowcase
+	NextCursor ChatCursor
+}
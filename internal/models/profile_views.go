@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type ProfileStats struct {
+	Views int
+	Likes int
+}
+
+func (s ProfileStats) LikeRate() float64 {
+	if s.Views == 0 {
+		return 0
+	}
+	return float64(s.Likes) / float64(s.Views)
+}
+
+type DailyProfileStats struct {
+	Day time.Time
+	ProfileStats
+}
+
+type ProfileViewStats struct {
+	Days  []DailyProfileStats
+	Total ProfileStats
+}
+
+type ProfileView struct {
+	ViewerID uint64
+	ViewedAt time.Time
+}
+
+type ProfileViewer struct {
+	UserID   uint64
+	Name     string
+	Photo    string
+	ViewedAt time.Time
+}
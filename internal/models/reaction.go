@@ -0,0 +1,30 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+var AllowedReactions = []string{"❤️", "👍", "😂", "😮", "😢", "🔥"}
+
+func ValidReaction(emoji string) bool {
+	return slices.Contains(AllowedReactions, emoji)
+}
+
+type Reaction struct {
+	MessageID uint64
+	UserID    uint64
+	Emoji     string
+	CreatedAt time.Time
+}
+
+type ReactionShowcase struct {
+	Emoji string
+	ByMe  bool
+}
+
+type ReactionNotification struct {
+	ChatID    uint64
+	MessageID uint64
+	Emoji     string
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type MessageMatch struct {
+	Message Message
+	Text    string
+}
+
+type TextRange struct {
+	Start int
+	End   int
+}
+
+type SearchHit struct {
+	ChatID      uint64
+	MessageID   uint64
+	SentByMe    bool
+	ContentType MsgContentType
+	Snippet     string
+	Highlights  []TextRange
+	CreatedAt   time.Time
+}
+
+type SearchResult struct {
+	Hits       []SearchHit
+	NextCursor uint64
+}
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+type TravelMode struct {
+	UserID       uint64
+	LocationLat  float64
+	LocationLon  float64
+	LocationName string
+	Discoverable bool
+	ExpiresAt    time.Time
+}
+
+func (t TravelMode) ActiveAt(now time.Time) bool {
+	return t.UserID != 0 && now.Before(t.ExpiresAt)
+}
+
+func (t TravelMode) ViewFrom(profile Profile) Profile {
+	if t.UserID == 0 {
+		return profile
+	}
+	profile.LocationLat = t.LocationLat
+	profile.LocationLon = t.LocationLon
+	profile.LocationName = t.LocationName
+	return profile
+}
+
+func (t TravelMode) ShownAt(profile Profile) Profile {
+	if !t.Discoverable {
+		return profile
+	}
+	return t.ViewFrom(profile)
+}
+
+func (t TravelMode) SearchFrom(prefs Preferences) Preferences {
+	if t.UserID == 0 {
+		return prefs
+	}
+	prefs.LocationLat = t.LocationLat
+	prefs.LocationLon = t.LocationLon
+	return prefs
+}
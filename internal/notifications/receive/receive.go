@@ -4,18 +4,24 @@ import (
 	"context"
 
 	notification_api "github.com/mayye4ka/pinder-api/notifications/go"
+	ws_api "github.com/mayye4ka/pinder/api/ws"
 	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/proto"
 )
 
-const notificationsExchangeName = "notifications"
+const (
+	notificationsExchangeName = "notifications"
+	seqHeader                 = "seq"
+	kindHeader                = "kind"
+)
 
 type NotificationReceiver struct {
 	rabbit     *amqp.Connection
 	logger     *zerolog.Logger
-	resultChan chan *notification_api.UserNotification
+	resultChan chan models.UserNotification
 	finish     chan struct{}
 	finishDone chan struct{}
 }
@@ -24,7 +30,7 @@ func NewNotificationReceiver(rabbit *amqp.Connection, logger *zerolog.Logger) *N
 	return &NotificationReceiver{
 		rabbit:     rabbit,
 		logger:     logger,
-		resultChan: make(chan *notification_api.UserNotification, 1024),
+		resultChan: make(chan models.UserNotification, 1024),
 		finish:     make(chan struct{}),
 		finishDone: make(chan struct{}),
 	}
@@ -35,7 +41,7 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		n.logger.Err(err).Msg("can't open rabbitmq channel")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -53,7 +59,7 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		n.logger.Err(err).Msg("can't declare rabbitmq exchange")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare rabbitmq exchange",
 		}
 	}
@@ -69,7 +75,7 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		n.logger.Err(err).Msg("can't declare rabbitmq queue")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare rabbitmq queue",
 		}
 	}
@@ -84,7 +90,7 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		n.logger.Err(err).Msg("can't bind rabbitmq queue")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't bind rabbitmq queue",
 		}
 	}
@@ -101,7 +107,7 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		n.logger.Err(err).Msg("can't start rabbitmq consumer")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't start rabbitmq consumer",
 		}
 	}
@@ -116,18 +122,52 @@ func (n *NotificationReceiver) Start(ctx context.Context) error {
 			close(n.finishDone)
 			return nil
 		case msg := <-msgs:
-			var notification notification_api.UserNotification
-			err = proto.Unmarshal(msg.Body, &notification)
+			notification, err := n.decode(msg)
 			if err != nil {
-				n.logger.Err(err).Msg("can't unmarshal notification")
-				return &errs.CodableError{
-					Code:    errs.CodeInternal,
-					Message: "can't unmarshal notification",
-				}
+				return err
+			}
+			n.resultChan <- notification
+		}
+	}
+}
+
+func (n *NotificationReceiver) decode(msg amqp.Delivery) (models.UserNotification, error) {
+	res := models.UserNotification{
+		Seq:  seqFromHeaders(msg.Headers),
+		Kind: kindFromHeaders(msg.Headers),
+	}
+	var err error
+	if res.Kind == models.NotificationEvent {
+		var event ws_api.UserEvent
+		if err = proto.Unmarshal(msg.Body, &event); err != nil {
+			n.logger.Err(err).Msg("can't unmarshal user event")
+			return models.UserNotification{}, &errs.CodableError{
+				Code:    errs.CodeFromCause(err),
+				Message: "can't unmarshal user event",
+			}
+		}
+		res.UserID = event.UserId
+		res.Payload, err = proto.Marshal(event.Event)
+	} else {
+		var notification notification_api.UserNotification
+		if err = proto.Unmarshal(msg.Body, &notification); err != nil {
+			n.logger.Err(err).Msg("can't unmarshal notification")
+			return models.UserNotification{}, &errs.CodableError{
+				Code:    errs.CodeFromCause(err),
+				Message: "can't unmarshal notification",
 			}
-			n.resultChan <- &notification
 		}
+		res.UserID = notification.UserId
+		res.Payload, err = proto.Marshal(notification.DataPackage)
 	}
+	if err != nil {
+		n.logger.Err(err).Msg("can't marshal notification payload")
+		return models.UserNotification{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't marshal notification payload",
+		}
+	}
+	return res, nil
 }
 
 func (n *NotificationReceiver) Stop(ctx context.Context) error {
@@ -139,6 +179,20 @@ func (n *NotificationReceiver) Stop(ctx context.Context) error {
 	return nil
 }
 
-func (n *NotificationReceiver) Notifications() <-chan *notification_api.UserNotification {
+func (n *NotificationReceiver) Notifications() <-chan models.UserNotification {
 	return n.resultChan
 }
+
+func kindFromHeaders(headers amqp.Table) models.NotificationKind {
+	if kind, ok := headers[kindHeader].(string); ok && kind != "" {
+		return models.NotificationKind(kind)
+	}
+	return models.NotificationData
+}
+
+func seqFromHeaders(headers amqp.Table) uint64 {
+	if seq, ok := headers[seqHeader].(int64); ok && seq > 0 {
+		return uint64(seq)
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	pruneInterval = time.Hour
+	pruneTimeout  = time.Minute
+)
+
+type Pruner struct {
+	store     NotificationStore
+	retention time.Duration
+	logger    *zerolog.Logger
+
+	finish     chan struct{}
+	finishDone chan struct{}
+}
+
+type NotificationStore interface {
+	DeleteNotificationsBefore(ctx context.Context, before time.Time) error
+}
+
+func NewPruner(store NotificationStore, retention time.Duration, logger *zerolog.Logger) *Pruner {
+	return &Pruner{
+		store:      store,
+		retention:  retention,
+		logger:     logger,
+		finish:     make(chan struct{}),
+		finishDone: make(chan struct{}),
+	}
+}
+
+func (p *Pruner) Start(ctx context.Context) error {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			close(p.finishDone)
+			return nil
+		case <-p.finish:
+			close(p.finishDone)
+			return nil
+		case <-ticker.C:
+			ctxTo, cancel := context.WithTimeout(ctx, pruneTimeout)
+			err := p.store.DeleteNotificationsBefore(ctxTo, time.Now().Add(-p.retention))
+			cancel()
+			if err != nil {
+				p.logger.Err(err).Msg("can't prune old notifications")
+			}
+		}
+	}
+}
+
+func (p *Pruner) Stop(ctx context.Context) error {
+	close(p.finish)
+	select {
+	case <-p.finishDone:
+	case <-ctx.Done():
+	}
+	return nil
+}
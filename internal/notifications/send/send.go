@@ -5,26 +5,38 @@ import (
 
 	public_api "github.com/mayye4ka/pinder-api/api/go"
 	notification_api "github.com/mayye4ka/pinder-api/notifications/go"
+	ws_api "github.com/mayye4ka/pinder/api/ws"
 	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const notificationsExchangeName = "notifications"
+const (
+	notificationsExchangeName = "notifications"
+	seqHeader                 = "seq"
+	kindHeader                = "kind"
+)
 
 type NotificationSender struct {
-	rabbit *amqp.Connection
-	logger *zerolog.Logger
+	rabbit      *amqp.Connection
+	store       NotificationStore
+	keepPerUser int
+	logger      *zerolog.Logger
+}
+
+type NotificationStore interface {
+	SaveNotification(ctx context.Context, userID uint64, kind models.NotificationKind, payload []byte, keep int) (uint64, error)
 }
 
-func NewNotificationSender(rabbit *amqp.Connection, logger *zerolog.Logger) (*NotificationSender, error) {
+func NewNotificationSender(rabbit *amqp.Connection, store NotificationStore, keepPerUser int, logger *zerolog.Logger) (*NotificationSender, error) {
 	ch, err := rabbit.Channel()
 	if err != nil {
 		logger.Err(err).Msg("can't open rabbitmq channel")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -42,18 +54,20 @@ func NewNotificationSender(rabbit *amqp.Connection, logger *zerolog.Logger) (*No
 	if err != nil {
 		logger.Err(err).Msg("can't declare rabbitmq exchange")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare rabbitmq exchange",
 		}
 	}
 	return &NotificationSender{
-		rabbit: rabbit,
-		logger: logger,
+		rabbit:      rabbit,
+		store:       store,
+		keepPerUser: keepPerUser,
+		logger:      logger,
 	}, nil
 }
 
 func (n *NotificationSender) SendMessage(ctx context.Context, userId uint64, notification models.MessageSend) error {
-	return n.notify(
+	err := n.notify(
 		ctx,
 		userId,
 		&public_api.DataPackage{
@@ -68,6 +82,23 @@ func (n *NotificationSender) SendMessage(ctx context.Context, userId uint64, not
 			},
 		},
 	)
+	if err != nil || notification.ReplyTo == nil {
+		return err
+	}
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_MessageReply{
+				MessageReply: &ws_api.MessageReply{
+					ChatId:    notification.ChatID,
+					MessageId: notification.MessageID,
+					ReplyTo:   messagePreviewToProto(*notification.ReplyTo),
+				},
+			},
+		},
+		true,
+	)
 }
 
 func (n *NotificationSender) NotifyLiked(ctx context.Context, userId uint64, notification models.LikeNotification) error {
@@ -115,23 +146,155 @@ func (n *NotificationSender) SendTranscribedMessage(ctx context.Context, userId
 	)
 }
 
+func (n *NotificationSender) NotifyBoostFinished(ctx context.Context, userId uint64, notification models.BoostFinishedNotification) error {
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_BoostFinished{
+				BoostFinished: &ws_api.BoostFinished{
+					Views: int32(notification.Views),
+					Likes: int32(notification.Likes),
+				},
+			},
+		},
+		true,
+	)
+}
+
+func (n *NotificationSender) NotifyRead(ctx context.Context, userId uint64, notification models.ReadReceipt) error {
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_ReadReceipt{
+				ReadReceipt: &ws_api.ReadReceipt{
+					ChatId:        notification.ChatID,
+					UpToMessageId: notification.UpToMessageID,
+				},
+			},
+		},
+		true,
+	)
+}
+
+func (n *NotificationSender) NotifyTyping(ctx context.Context, userId uint64, notification models.TypingNotification) error {
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_Typing{
+				Typing: &ws_api.Typing{
+					ChatId: notification.ChatID,
+					Typing: notification.Typing,
+				},
+			},
+		},
+		false,
+	)
+}
+
+func (n *NotificationSender) NotifyMessageChanged(ctx context.Context, userId uint64, notification models.MessageChange) error {
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_MessageChanged{
+				MessageChanged: &ws_api.MessageChanged{
+					ChatId:    notification.ChatID,
+					MessageId: notification.MessageID,
+					Kind:      messageChangeKindToProto(notification.Kind),
+					Text:      notification.Payload,
+				},
+			},
+		},
+		true,
+	)
+}
+
+func (n *NotificationSender) NotifyReaction(ctx context.Context, userId uint64, notification models.ReactionNotification) error {
+	return n.notifyEvent(
+		ctx,
+		userId,
+		&ws_api.Event{
+			Event: &ws_api.Event_ReactionChanged{
+				ReactionChanged: &ws_api.ReactionChanged{
+					ChatId:    notification.ChatID,
+					MessageId: notification.MessageID,
+					Emoji:     notification.Emoji,
+				},
+			},
+		},
+		true,
+	)
+}
+
 func (n *NotificationSender) notify(ctx context.Context, userId uint64, data *public_api.DataPackage) error {
+	payload, err := proto.Marshal(data)
+	if err != nil {
+		n.logger.Err(err).Msg("can't marshal data package")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't marshal data package",
+		}
+	}
 	bytes, err := proto.Marshal(&notification_api.UserNotification{
 		UserId:      userId,
 		DataPackage: data,
 	})
 	if err != nil {
-		n.logger.Err(err).Msg("can't unmarshal notification")
+		n.logger.Err(err).Msg("can't marshal notification")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't marshal notification",
+		}
+	}
+	return n.publish(ctx, userId, models.NotificationData, payload, bytes, true)
+}
+
+// notifyEvent publishes an event that has no place in pinder-api's
+// DataPackage. Events that only matter while the user is online are not
+// persisted and carry no seq.
+func (n *NotificationSender) notifyEvent(ctx context.Context, userId uint64, event *ws_api.Event, persist bool) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		n.logger.Err(err).Msg("can't marshal event")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't marshal event",
+		}
+	}
+	bytes, err := proto.Marshal(&ws_api.UserEvent{
+		UserId: userId,
+		Event:  event,
+	})
+	if err != nil {
+		n.logger.Err(err).Msg("can't marshal user event")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
-			Message: "can't unmarshal notification",
+			Code:    errs.CodeFromCause(err),
+			Message: "can't marshal user event",
+		}
+	}
+	return n.publish(ctx, userId, models.NotificationEvent, payload, bytes, persist)
+}
+
+func (n *NotificationSender) publish(ctx context.Context, userId uint64, kind models.NotificationKind, payload, body []byte, persist bool) error {
+	var seq uint64
+	if persist {
+		var err error
+		seq, err = n.store.SaveNotification(ctx, userId, kind, payload, n.keepPerUser)
+		if err != nil {
+			// An online user should still get it live. Without a seq it just
+			// can't be replayed on reconnect, like unpersisted events.
+			n.logger.Err(err).Uint64("user_id", userId).Msg("can't save notification, publishing without seq")
+			seq = 0
 		}
 	}
 	ch, err := n.rabbit.Channel()
 	if err != nil {
 		n.logger.Err(err).Msg("can't open rabbitmq channel")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -144,13 +307,17 @@ func (n *NotificationSender) notify(ctx context.Context, userId uint64, data *pu
 		false,
 		amqp.Publishing{
 			ContentType: "text/plain",
-			Body:        []byte(bytes),
+			Headers: amqp.Table{
+				seqHeader:  int64(seq),
+				kindHeader: string(kind),
+			},
+			Body: body,
 		},
 	)
 	if err != nil {
 		n.logger.Err(err).Msg("can't send notification")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't send notification",
 		}
 	}
@@ -167,3 +334,34 @@ func msgContentTypeToProto(ct models.MsgContentType) public_api.MESSAGE_CONTENT_
 		return public_api.MESSAGE_CONTENT_TYPE_TEXT
 	}
 }
+
+func messagePreviewToProto(preview models.MessagePreview) *ws_api.MessagePreview {
+	return &ws_api.MessagePreview{
+		Id:          preview.ID,
+		SentByMe:    preview.SentByMe,
+		ContentType: msgContentTypeToWsProto(preview.ContentType),
+		Preview:     preview.Preview,
+		CreatedAt:   timestamppb.New(preview.CreatedAt),
+		Deleted:     preview.Deleted,
+	}
+}
+
+func msgContentTypeToWsProto(ct models.MsgContentType) ws_api.ContentType {
+	switch ct {
+	case models.ContentPhoto:
+		return ws_api.ContentType_CONTENT_TYPE_PHOTO
+	case models.ContentVoice:
+		return ws_api.ContentType_CONTENT_TYPE_VOICE
+	default:
+		return ws_api.ContentType_CONTENT_TYPE_TEXT
+	}
+}
+
+func messageChangeKindToProto(kind models.MessageChangeKind) ws_api.MessageChangeKind {
+	switch kind {
+	case models.MessageDeleted:
+		return ws_api.MessageChangeKind_MESSAGE_CHANGE_DELETED
+	default:
+		return ws_api.MessageChangeKind_MESSAGE_CHANGE_EDITED
+	}
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+type AuditRecord struct {
+	ID        uint64
+	ActorID   uint64
+	Action    string
+	TargetID  uint64
+	Details   string
+	IP        string
+	UserAgent string
+	CreatedAt time.Time
+}
+
+// maxUserAgentLen is the width of audit_log.user_agent.
+const maxUserAgentLen = 255
+
+func (AuditRecord) TableName() string {
+	return "audit_log"
+}
+
+func (r *Repository) CreateAuditRecord(ctx context.Context, record models.AuditRecord) error {
+	rec := AuditRecord{
+		ActorID:   record.ActorID,
+		Action:    string(record.Action),
+		TargetID:  record.TargetID,
+		Details:   record.Details,
+		IP:        record.Meta.IP,
+		UserAgent: truncateRunes(record.Meta.UserAgent, maxUserAgentLen),
+		CreatedAt: time.Now(),
+	}
+	res := r.db.WithContext(ctx).Create(&rec)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't create audit record")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't create audit record",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) GetAuditRecords(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	q := r.db.WithContext(ctx).Model(&AuditRecord{}).Where("id > ?", filter.AfterID)
+	if filter.ActorID != 0 {
+		q = q.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.TargetID != 0 {
+		q = q.Where("target_id = ?", filter.TargetID)
+	}
+	if filter.Action != "" {
+		q = q.Where("action = ?", string(filter.Action))
+	}
+	if !filter.From.IsZero() {
+		q = q.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		q = q.Where("created_at < ?", filter.To)
+	}
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+	var records []AuditRecord
+	res := q.Order("id").Find(&records)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get audit records")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get audit records",
+		}
+	}
+	return mapAuditRecords(records), nil
+}
+
+func mapAuditRecords(records []AuditRecord) []models.AuditRecord {
+	res := make([]models.AuditRecord, len(records))
+	for i, rec := range records {
+		res[i] = mapAuditRecord(rec)
+	}
+	return res
+}
+
+func mapAuditRecord(rec AuditRecord) models.AuditRecord {
+	return models.AuditRecord{
+		ID:       rec.ID,
+		ActorID:  rec.ActorID,
+		Action:   models.AuditAction(rec.Action),
+		TargetID: rec.TargetID,
+		Details:  rec.Details,
+		Meta: models.RequestMeta{
+			IP:        rec.IP,
+			UserAgent: rec.UserAgent,
+		},
+		CreatedAt: rec.CreatedAt,
+	}
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
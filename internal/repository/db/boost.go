@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type Boost struct {
+	ID            uint64
+	UserID        uint64
+	StartedAt     time.Time
+	EndsAt        time.Time
+	Reported      bool
+	Views         int
+	Likes         int
+	BaselineViews int
+	BaselineLikes int
+}
+
+func (Boost) TableName() string {
+	return "boosts"
+}
+
+// CreateBoost inserts the boost only if the user has none started after
+// cooldownFrom, so concurrent requests can't both pass the cooldown. It
+// reports false when the cooldown blocked the insert.
+func (r *Repository) CreateBoost(ctx context.Context, userID uint64, startedAt, endsAt, cooldownFrom time.Time) (models.Boost, bool, error) {
+	boost := Boost{
+		UserID:    userID,
+		StartedAt: startedAt,
+		EndsAt:    endsAt,
+	}
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(
+			"insert into boosts(user_id, started_at, ends_at) select ?, ?, ? from dual "+
+				"where not exists (select 1 from boosts where user_id = ? and started_at > ?)",
+			userID, startedAt, endsAt, userID, cooldownFrom,
+		)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		created = true
+		return tx.Raw("select last_insert_id()").Scan(&boost.ID).Error
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't create boost")
+		return models.Boost{}, false, &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't create boost",
+		}
+	}
+	return mapBoost(boost), created, nil
+}
+
+func (r *Repository) GetLatestBoost(ctx context.Context, userID uint64) (models.Boost, error) {
+	var boost Boost
+	res := r.db.WithContext(ctx).Model(&Boost{}).Where("user_id = ?", userID).Order("started_at desc").First(&boost)
+	if res.Error != nil && errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return models.Boost{}, nil
+	} else if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get latest boost")
+		return models.Boost{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get latest boost",
+		}
+	}
+	return mapBoost(boost), nil
+}
+
+func (r *Repository) GetBoostedUsers(ctx context.Context, at time.Time) ([]uint64, error) {
+	var ids []uint64
+	res := r.db.WithContext(ctx).Model(&Boost{}).
+		Where("started_at <= ? and ends_at > ?", at, at).
+		Distinct().Pluck("user_id", &ids)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get boosted users")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get boosted users",
+		}
+	}
+	return ids, nil
+}
+
+func (r *Repository) GetUnreportedBoosts(ctx context.Context, endedBefore time.Time) ([]models.Boost, error) {
+	var boosts []Boost
+	res := r.db.WithContext(ctx).Model(&Boost{}).
+		Where("ends_at <= ? and reported = ?", endedBefore, false).
+		Order("ends_at").Find(&boosts)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get unreported boosts")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get unreported boosts",
+		}
+	}
+	result := make([]models.Boost, len(boosts))
+	for i, b := range boosts {
+		result[i] = mapBoost(b)
+	}
+	return result, nil
+}
+
+func (r *Repository) ReportBoost(ctx context.Context, boostID uint64, result models.BoostResult) error {
+	res := r.db.WithContext(ctx).Model(&Boost{}).Where("id = ?", boostID).Updates(map[string]any{
+		"reported":       true,
+		"views":          result.During.Views,
+		"likes":          result.During.Likes,
+		"baseline_views": result.Baseline.Views,
+		"baseline_likes": result.Baseline.Likes,
+	})
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't report boost")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't report boost",
+		}
+	}
+	return nil
+}
+
+func mapBoost(boost Boost) models.Boost {
+	return models.Boost{
+		ID:        boost.ID,
+		UserID:    boost.UserID,
+		StartedAt: boost.StartedAt,
+		EndsAt:    boost.EndsAt,
+		Reported:  boost.Reported,
+		Result: models.BoostResult{
+			During: models.ProfileStats{
+				Views: boost.Views,
+				Likes: boost.Likes,
+			},
+			Baseline: models.ProfileStats{
+				Views: boost.BaselineViews,
+				Likes: boost.BaselineLikes,
+			},
+		},
+	}
+}
@@ -3,32 +3,48 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Chat struct {
-	ID    uint64
-	User1 uint64
-	User2 uint64
+	ID             uint64
+	User1          uint64
+	User2          uint64
+	LastMessageID  *uint64
+	LastActivityAt time.Time
 }
 
 func (Chat) TableName() string {
 	return "chats"
 }
 
+type ChatReadCursor struct {
+	ChatID    uint64
+	UserID    uint64
+	MessageID uint64
+	UpdatedAt time.Time
+}
+
+func (ChatReadCursor) TableName() string {
+	return "chat_read_cursors"
+}
+
 func (r *Repository) CreateChat(ctx context.Context, user1, user2 uint64) error {
 	chat := Chat{
-		User1: user1,
-		User2: user2,
+		User1:          user1,
+		User2:          user2,
+		LastActivityAt: time.Now(),
 	}
 	res := r.db.WithContext(ctx).Create(&chat)
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't create chat")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't create chat",
 		}
 	}
@@ -41,7 +57,7 @@ func (r *Repository) GetChats(ctx context.Context, userID uint64) ([]models.Chat
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get chats")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get chats",
 		}
 	}
@@ -50,23 +66,160 @@ func (r *Repository) GetChats(ctx context.Context, userID uint64) ([]models.Chat
 
 func (r *Repository) GetChat(ctx context.Context, id uint64) (models.Chat, error) {
 	var chat Chat
-	res := r.db.WithContext(ctx).Model(&Chat{}).Where("id = ?", id).Find(&chat)
+	res := r.db.WithContext(ctx).Model(&Chat{}).Where("id = ?", id).First(&chat)
 	if res.Error != nil {
 		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 			return models.Chat{}, &errs.CodableError{
 				Code:    errs.CodeNotFound,
 				Message: "no such chat",
+				Reason:  errs.ReasonChatNotFound,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't find chat")
 		return models.Chat{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't find chat",
 		}
 	}
 	return mapChat(chat), nil
 }
 
+func (r *Repository) GetChatSummaries(ctx context.Context, userID uint64, page models.ChatPage) ([]models.ChatSummary, error) {
+	var chats []Chat
+	q := r.db.WithContext(ctx).Model(&Chat{}).Where("(user1 = ? or user2 = ?)", userID, userID)
+	if !page.Cursor.IsZero() {
+		at := page.Cursor.ActivityAt
+		q = q.Where("(last_activity_at < ? or (last_activity_at = ? and id < ?))", at, at, page.Cursor.ChatID)
+	}
+	res := q.Order("last_activity_at desc, id desc").Limit(page.Limit).Find(&chats)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get chats")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get chats",
+		}
+	}
+	if len(chats) == 0 {
+		return []models.ChatSummary{}, nil
+	}
+	chatIDs := make([]uint64, len(chats))
+	for i, chat := range chats {
+		chatIDs[i] = chat.ID
+	}
+	// chats.last_message_id can't be used: the viewer may have hidden it.
+	lastVisible := r.db.WithContext(ctx).Table("messages m").
+		Select("max(m.id)").
+		Where("m.chat_id in ?", chatIDs).
+		Where("not exists (select 1 from message_hides h where h.user_id = ? and h.message_id = m.id)", userID).
+		Group("m.chat_id")
+	var messages []Message
+	res = r.db.WithContext(ctx).Model(&Message{}).Where("id in (?)", lastVisible).Find(&messages)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get last messages")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get last messages",
+		}
+	}
+	lastMessages := make(map[uint64]Message, len(messages))
+	for _, msg := range messages {
+		lastMessages[msg.ChatID] = msg
+	}
+	var unread []struct {
+		ChatID uint64
+		Count  int
+	}
+	res = r.db.WithContext(ctx).Table("messages m").
+		Select("m.chat_id, count(*) as count").
+		Joins("left join chat_read_cursors c on c.chat_id = m.chat_id and c.user_id = ?", userID).
+		Where("m.chat_id in ? and m.sender_id <> ? and m.id > coalesce(c.message_id, 0)", chatIDs, userID).
+		Where("m.deleted_at is null").
+		Where("not exists (select 1 from message_hides h where h.user_id = ? and h.message_id = m.id)", userID).
+		Group("m.chat_id").
+		Scan(&unread)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't count unread messages")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't count unread messages",
+		}
+	}
+	unreadCounts := make(map[uint64]int, len(unread))
+	for _, row := range unread {
+		unreadCounts[row.ChatID] = row.Count
+	}
+	result := make([]models.ChatSummary, len(chats))
+	for i, chat := range chats {
+		result[i] = models.ChatSummary{
+			Chat:        mapChat(chat),
+			UnreadCount: unreadCounts[chat.ID],
+		}
+		if msg, ok := lastMessages[chat.ID]; ok {
+			lastMessage := mapMessage(msg)
+			result[i].LastMessage = &lastMessage
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) MarkRead(ctx context.Context, chatID, userID, messageID uint64) (bool, error) {
+	advanced := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cursor ChatReadCursor
+		res := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("chat_id = ? and user_id = ?", chatID, userID).
+			Limit(1).Find(&cursor)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected > 0 && cursor.MessageID >= messageID {
+			return nil
+		}
+		advanced = true
+		return advanceReadCursor(tx, chatID, userID, messageID)
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't mark messages as read")
+		return false, &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't mark messages as read",
+		}
+	}
+	return advanced, nil
+}
+
+func (r *Repository) GetReadCursors(ctx context.Context, chatID uint64) (map[uint64]uint64, error) {
+	var cursors []ChatReadCursor
+	res := r.db.WithContext(ctx).Model(&ChatReadCursor{}).Where("chat_id = ?", chatID).Find(&cursors)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get read cursors")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get read cursors",
+		}
+	}
+	result := make(map[uint64]uint64, len(cursors))
+	for _, cursor := range cursors {
+		result[cursor.UserID] = cursor.MessageID
+	}
+	return result, nil
+}
+
+func advanceReadCursor(tx *gorm.DB, chatID, userID, messageID uint64) error {
+	cursor := ChatReadCursor{
+		ChatID:    chatID,
+		UserID:    userID,
+		MessageID: messageID,
+		UpdatedAt: time.Now(),
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"message_id": gorm.Expr("greatest(message_id, values(message_id))"),
+			"updated_at": gorm.Expr("values(updated_at)"),
+		}),
+	}).Create(&cursor).Error
+}
+
 func mapChats(chats []Chat) []models.Chat {
 	res := make([]models.Chat, len(chats))
 	for i, chat := range chats {
@@ -77,8 +230,9 @@ func mapChats(chats []Chat) []models.Chat {
 
 func mapChat(chat Chat) models.Chat {
 	return models.Chat{
-		ID:    chat.ID,
-		User1: chat.User1,
-		User2: chat.User2,
+		ID:             chat.ID,
+		User1:          chat.User1,
+		User2:          chat.User2,
+		LastActivityAt: chat.LastActivityAt,
 	}
 }
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/mayye4ka/pinder/internal/errs"
@@ -19,47 +20,90 @@ const (
 )
 
 type Message struct {
-	ID          uint64
-	ChatID      uint64
-	SenderID    uint64
-	ContentType MsgContentType
-	Payload     string
-	CreatedAt   time.Time
+	ID              uint64
+	ChatID          uint64
+	SenderID        uint64
+	ContentType     MsgContentType
+	Payload         string
+	ClientMessageID *string
+	ReplyToID       *uint64
+	CreatedAt       time.Time
+	EditedAt        *time.Time
+	DeletedAt       *time.Time
 }
 
 func (Message) TableName() string {
 	return "messages"
 }
 
-func (r *Repository) SendMessage(ctx context.Context, chatID, sender uint64, contentType models.MsgContentType, payload string) (models.Message, error) {
+func (r *Repository) SendMessage(ctx context.Context, sender uint64, msg models.OutgoingMessage) (models.Message, error) {
 	message := Message{
-		ChatID:      chatID,
+		ChatID:      msg.ChatID,
 		SenderID:    sender,
-		ContentType: unmapContentType(contentType),
-		Payload:     payload,
+		ContentType: unmapContentType(msg.ContentType),
+		Payload:     msg.Payload,
 		CreatedAt:   time.Now(),
 	}
-	res := r.db.WithContext(ctx).Create(&message)
-	if res.Error != nil {
-		r.logger.Err(res.Error).Msg("can't send message")
+	if msg.ClientMessageID != "" {
+		message.ClientMessageID = &msg.ClientMessageID
+	}
+	if msg.ReplyToID != 0 {
+		message.ReplyToID = &msg.ReplyToID
+	}
+	chatID := msg.ChatID
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&message).Error; err != nil {
+			return err
+		}
+		err := tx.Model(&Chat{}).Where("id = ?", chatID).Updates(map[string]any{
+			"last_message_id":  message.ID,
+			"last_activity_at": message.CreatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return advanceReadCursor(tx, chatID, sender, message.ID)
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return models.Message{}, &errs.CodableError{
+				Code:    errs.CodeAlreadyExists,
+				Message: "message with this client id already sent",
+			}
+		}
+		r.logger.Err(err).Msg("can't send message")
 		return models.Message{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't send message",
 		}
 	}
 	return mapMessage(message), nil
 }
 
-func (r *Repository) GetMessages(ctx context.Context, chatID uint64) ([]models.Message, error) {
+func (r *Repository) GetMessages(ctx context.Context, chatID, viewerID uint64, page models.MessagePage) ([]models.Message, error) {
 	var messages []Message
-	res := r.db.WithContext(ctx).Model(&Message{}).Where("chat_id = ?", chatID).Order("created_at").Find(&messages)
+	q := r.db.WithContext(ctx).Model(&Message{}).
+		Where("chat_id = ?", chatID).
+		Where("not exists (select 1 from message_hides h where h.user_id = ? and h.message_id = messages.id)", viewerID)
+	if page.Direction == models.PageNewer {
+		q = q.Where("id > ?", page.Cursor).Order("id")
+	} else {
+		if page.Cursor != 0 {
+			q = q.Where("id < ?", page.Cursor)
+		}
+		q = q.Order("id desc")
+	}
+	res := q.Limit(page.Limit).Find(&messages)
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get messages in this chat")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get messages in this chat",
 		}
 	}
+	if page.Direction != models.PageNewer {
+		slices.Reverse(messages)
+	}
 	return mapMessages(messages), nil
 }
 
@@ -71,17 +115,81 @@ func (r *Repository) GetMessage(ctx context.Context, msgID uint64) (models.Messa
 			return models.Message{}, &errs.CodableError{
 				Code:    errs.CodeNotFound,
 				Message: "not found this message",
+				Reason:  errs.ReasonMessageNotFound,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't get message")
 		return models.Message{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get message",
 		}
 	}
 	return mapMessage(message), nil
 }
 
+func (r *Repository) GetMessagesByIDs(ctx context.Context, msgIDs []uint64) (map[uint64]models.Message, error) {
+	result := make(map[uint64]models.Message, len(msgIDs))
+	if len(msgIDs) == 0 {
+		return result, nil
+	}
+	var messages []Message
+	res := r.db.WithContext(ctx).Model(&Message{}).Where("id in ?", msgIDs).Find(&messages)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get messages by ids")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get messages by ids",
+		}
+	}
+	for _, msg := range messages {
+		result[msg.ID] = mapMessage(msg)
+	}
+	return result, nil
+}
+
+func (r *Repository) GetMessageByClientID(ctx context.Context, sender uint64, clientMessageID string) (models.Message, bool, error) {
+	var messages []Message
+	res := r.db.WithContext(ctx).Model(&Message{}).
+		Where("sender_id = ? and client_message_id = ?", sender, clientMessageID).
+		Limit(1).Find(&messages)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get message by client id")
+		return models.Message{}, false, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get message by client id",
+		}
+	}
+	if len(messages) == 0 {
+		return models.Message{}, false, nil
+	}
+	return mapMessage(messages[0]), true, nil
+}
+
+func (r *Repository) GetChatActivity(ctx context.Context, chatID uint64) (models.ChatActivity, error) {
+	var activity struct {
+		MessageCount  int
+		LastMessageAt *time.Time
+	}
+	res := r.db.WithContext(ctx).Model(&Message{}).
+		Select("count(*) as message_count, max(created_at) as last_message_at").
+		Where("chat_id = ?", chatID).
+		Scan(&activity)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get chat activity")
+		return models.ChatActivity{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get chat activity",
+		}
+	}
+	result := models.ChatActivity{
+		MessageCount: activity.MessageCount,
+	}
+	if activity.LastMessageAt != nil {
+		result.LastMessageAt = *activity.LastMessageAt
+	}
+	return result, nil
+}
+
 func mapMessages(msgs []Message) []models.Message {
 	res := make([]models.Message, len(msgs))
 	for i, msg := range msgs {
@@ -91,7 +199,7 @@ func mapMessages(msgs []Message) []models.Message {
 }
 
 func mapMessage(msg Message) models.Message {
-	return models.Message{
+	res := models.Message{
 		ID:          msg.ID,
 		ChatID:      msg.ChatID,
 		SenderID:    msg.SenderID,
@@ -99,6 +207,16 @@ func mapMessage(msg Message) models.Message {
 		Payload:     msg.Payload,
 		CreatedAt:   msg.CreatedAt,
 	}
+	if msg.ReplyToID != nil {
+		res.ReplyToID = *msg.ReplyToID
+	}
+	if msg.EditedAt != nil {
+		res.EditedAt = *msg.EditedAt
+	}
+	if msg.DeletedAt != nil {
+		res.DeletedAt = *msg.DeletedAt
+	}
+	return res
 }
 
 func mapContentType(ct MsgContentType) models.MsgContentType {
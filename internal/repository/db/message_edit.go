@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MessageEdit struct {
+	ID        uint64
+	MessageID uint64
+	Payload   string
+	EditedAt  time.Time
+}
+
+func (MessageEdit) TableName() string {
+	return "message_edits"
+}
+
+type MessageHide struct {
+	MessageID uint64
+	UserID    uint64
+	HiddenAt  time.Time
+}
+
+func (MessageHide) TableName() string {
+	return "message_hides"
+}
+
+var errMessageDeleted = &errs.CodableError{
+	Code:    errs.CodeFailedPrecondition,
+	Message: "message is deleted",
+	Reason:  errs.ReasonMessageDeleted,
+}
+
+func (r *Repository) EditMessage(ctx context.Context, msgID uint64, payload string, at time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var message Message
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", msgID).First(&message).Error
+		if err != nil {
+			return err
+		}
+		edit := MessageEdit{
+			MessageID: msgID,
+			Payload:   message.Payload,
+			EditedAt:  at,
+		}
+		if err := tx.Create(&edit).Error; err != nil {
+			return err
+		}
+		res := tx.Model(&Message{}).Where("id = ? and deleted_at is null", msgID).Updates(map[string]any{
+			"payload":   payload,
+			"edited_at": at,
+		})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errMessageDeleted
+		}
+		return nil
+	})
+	if errors.Is(err, errMessageDeleted) {
+		return errMessageDeleted
+	}
+	if err != nil {
+		r.logger.Err(err).Msg("can't edit message")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't edit message",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) DeleteMessage(ctx context.Context, msgID uint64, at time.Time) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&Message{}).Where("id = ?", msgID).Updates(map[string]any{
+			"payload":    "",
+			"deleted_at": at,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("message_id = ?", msgID).Delete(&MessageEdit{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("message_id = ?", msgID).Delete(&MessageReaction{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("message_id = ?", msgID).Delete(&MessageTranscription{}).Error
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't delete message")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't delete message",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) HideMessage(ctx context.Context, msgID, userID uint64) error {
+	hide := MessageHide{
+		MessageID: msgID,
+		UserID:    userID,
+		HiddenAt:  time.Now(),
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&hide)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't hide message")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't hide message",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) GetHiddenMessageIDs(ctx context.Context, userID uint64, msgIDs []uint64) (map[uint64]bool, error) {
+	result := map[uint64]bool{}
+	if len(msgIDs) == 0 {
+		return result, nil
+	}
+	var ids []uint64
+	res := r.db.WithContext(ctx).Model(&MessageHide{}).
+		Where("user_id = ? and message_id in ?", userID, msgIDs).
+		Pluck("message_id", &ids)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get hidden messages")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get hidden messages",
+		}
+	}
+	for _, id := range ids {
+		result[id] = true
+	}
+	return result, nil
+}
+
+func (r *Repository) GetMessageEdits(ctx context.Context, msgID uint64) ([]models.MessageEdit, error) {
+	var edits []MessageEdit
+	res := r.db.WithContext(ctx).Model(&MessageEdit{}).Where("message_id = ?", msgID).Order("id").Find(&edits)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get message edits")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get message edits",
+		}
+	}
+	result := make([]models.MessageEdit, len(edits))
+	for i, edit := range edits {
+		result[i] = models.MessageEdit{
+			MessageID: edit.MessageID,
+			Payload:   edit.Payload,
+			EditedAt:  edit.EditedAt,
+		}
+	}
+	return result, nil
+}
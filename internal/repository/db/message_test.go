@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// failingDriver fails every statement with the given MySQL error, the way
+// the server reports constraint violations.
+type failingDriver struct {
+	err *mysqldriver.MySQLError
+}
+
+func (d failingDriver) Open(string) (driver.Conn, error) {
+	return failingConn(d), nil
+}
+
+type failingConn failingDriver
+
+func (c failingConn) Prepare(string) (driver.Stmt, error) { return nil, c.err }
+func (c failingConn) Close() error                        { return nil }
+func (c failingConn) Begin() (driver.Tx, error)           { return failingTx{}, nil }
+
+func (c failingConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return nil, c.err
+}
+
+type failingTx struct{}
+
+func (failingTx) Commit() error   { return nil }
+func (failingTx) Rollback() error { return nil }
+
+func newFailingRepository(t *testing.T, err *mysqldriver.MySQLError) *Repository {
+	name := t.Name()
+	sql.Register(name, failingDriver{err: err})
+	conn, openErr := sql.Open(name, "")
+	require.NoError(t, openErr)
+	t.Cleanup(func() { conn.Close() })
+	db, openErr := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      conn,
+		SkipInitializeWithVersion: true,
+	}), GormConfig())
+	require.NoError(t, openErr)
+	logger := zerolog.New(io.Discard)
+	return New(db, &logger)
+}
+
+func TestSendMessage_DuplicateClientID(t *testing.T) {
+	repo := newFailingRepository(t, &mysqldriver.MySQLError{
+		Number:  1062,
+		Message: "Duplicate entry '1-abc' for key 'messages.sender_client_message_id'",
+	})
+
+	_, err := repo.SendMessage(context.Background(), 1, models.OutgoingMessage{
+		ChatID:          1,
+		ContentType:     models.ContentText,
+		Payload:         "hi",
+		ClientMessageID: "abc",
+	})
+
+	require.Equal(t, errs.CodeAlreadyExists, errs.CodeOf(err))
+}
+
+func TestCreateUser_PhoneTaken(t *testing.T) {
+	repo := newFailingRepository(t, &mysqldriver.MySQLError{
+		Number:  1062,
+		Message: "Duplicate entry '123' for key 'users.phone_number'",
+	})
+
+	_, err := repo.CreateUser(context.Background(), "123", "hash")
+
+	require.Equal(t, errs.CodeAlreadyExists, errs.CodeOf(err))
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"gorm.io/gorm"
+)
+
+type Notification struct {
+	UserID    uint64
+	Seq       uint64
+	Kind      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+func (r *Repository) SaveNotification(ctx context.Context, userID uint64, kind models.NotificationKind, payload []byte, keep int) (uint64, error) {
+	notification := Notification{
+		UserID:    userID,
+		Kind:      string(kind),
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("insert into notification_sequences(user_id, seq) values (?, 1) on duplicate key update seq = seq + 1", userID).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Raw("select seq from notification_sequences where user_id = ?", userID).Scan(&notification.Seq).Error
+		if err != nil {
+			return err
+		}
+		if err = tx.Create(&notification).Error; err != nil {
+			return err
+		}
+		if notification.Seq <= uint64(keep) {
+			return nil
+		}
+		return tx.Where("user_id = ? and seq <= ?", userID, notification.Seq-uint64(keep)).Delete(&Notification{}).Error
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't save notification")
+		return 0, &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
+			Message: "can't save notification",
+		}
+	}
+	return notification.Seq, nil
+}
+
+func (r *Repository) GetNotificationsAfter(ctx context.Context, userID, afterSeq uint64, limit int) ([]models.UserNotification, error) {
+	var notifications []Notification
+	res := r.db.WithContext(ctx).Model(&Notification{}).
+		Where("user_id = ? and seq > ?", userID, afterSeq).
+		Order("seq").Limit(limit).Find(&notifications)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get notifications")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get notifications",
+		}
+	}
+	result := make([]models.UserNotification, len(notifications))
+	for i, n := range notifications {
+		result[i] = models.UserNotification{
+			UserID:    n.UserID,
+			Seq:       n.Seq,
+			Kind:      models.NotificationKind(n.Kind),
+			Payload:   n.Payload,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) DeleteNotificationsBefore(ctx context.Context, before time.Time) error {
+	res := r.db.WithContext(ctx).Where("created_at < ?", before).Delete(&Notification{})
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't delete old notifications")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't delete old notifications",
+		}
+	}
+	return nil
+}
@@ -38,7 +38,7 @@ func (r *Repository) GetLatestPairAttempt(ctx context.Context, user1, user2 uint
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't latest pair attempt")
 		return models.PairAttempt{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get latest pair attempt",
 		}
 	}
@@ -54,7 +54,7 @@ func (r *Repository) GetPendingPairAttemptByUserPair(ctx context.Context, user1,
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get pending pa for this pair")
 		return models.PairAttempt{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get pending pa for this pair",
 		}
 	}
@@ -71,7 +71,7 @@ func (r *Repository) GetLatestPairAttemptByUserPair(ctx context.Context, user1,
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get latest pa for this pair")
 		return models.PairAttempt{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get latest pa for this pair",
 		}
 	}
@@ -83,7 +83,7 @@ func (r *Repository) FinishPairAttempt(ctx context.Context, PAID uint64, state m
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't finish pair attempt")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't finish pair attempt",
 		}
 	}
@@ -101,7 +101,7 @@ func (r *Repository) CreatePairAttempt(ctx context.Context, user1, user2 uint64)
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't create pair attempt")
 		return models.PairAttempt{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't create pair attempt",
 		}
 	}
@@ -112,6 +112,9 @@ func (r *Repository) GetWhoLikedMe(ctx context.Context, userID uint64) (uint64,
 	var pair PairAttempt
 	res := r.db.WithContext(ctx).Model(&PairAttempt{}).
 		Where("user2 = ? and state = ?", userID, PAStatePending).
+		Where("user1 not in (?) or exists (?)",
+			r.db.Model(&User{}).Select("id").Where("incognito = ?", true),
+			r.db.Model(&PairEvent{}).Select("1").Where("pa_id = pair_attempts.id and event_type = ?", PETypeUser1Liked)).
 		Order("created_at").First(&pair)
 	if res.Error != nil {
 		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
@@ -119,13 +122,36 @@ func (r *Repository) GetWhoLikedMe(ctx context.Context, userID uint64) (uint64,
 		}
 		r.logger.Err(res.Error).Msg("can't get who likes you")
 		return 0, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get who likes you",
 		}
 	}
 	return pair.User1, nil
 }
 
+func (r *Repository) GetHiddenUsers(ctx context.Context, viewerID uint64) ([]uint64, error) {
+	var ids []uint64
+	res := r.db.WithContext(ctx).Model(&User{}).
+		Where("incognito = ?", true).
+		Where("id not in (?)", r.db.Model(&PairAttempt{}).
+			Select("pair_attempts.user1").
+			Joins("join pair_events on pair_events.pa_id = pair_attempts.id").
+			Where("pair_attempts.user2 = ? and pair_events.event_type = ?", viewerID, PETypeUser1Liked)).
+		Where("id not in (?)", r.db.Model(&PairAttempt{}).
+			Select("pair_attempts.user2").
+			Joins("join pair_events on pair_events.pa_id = pair_attempts.id").
+			Where("pair_attempts.user1 = ? and pair_events.event_type = ?", viewerID, PETypeUser2Liked)).
+		Pluck("id", &ids)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get hidden users")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get hidden users",
+		}
+	}
+	return ids, nil
+}
+
 func (r *Repository) GetPendingPairAttempts(ctx context.Context, user1ID uint64) ([]models.PairAttempt, error) {
 	var pas []PairAttempt
 	res := r.db.WithContext(ctx).Model(&PairAttempt{}).
@@ -134,13 +160,29 @@ func (r *Repository) GetPendingPairAttempts(ctx context.Context, user1ID uint64)
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get pending pair attempts")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get pending pair attempts",
 		}
 	}
 	return mapPairAttempts(pas), nil
 }
 
+func (r *Repository) GetUserPairAttempts(ctx context.Context, userID uint64) ([]models.PairAttempt, error) {
+	var pas []PairAttempt
+	res := r.db.WithContext(ctx).Model(&PairAttempt{}).
+		Where("user1 = ? or user2 = ?", userID, userID).
+		Order("created_at desc").
+		Find(&pas)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get user pair attempts")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get user pair attempts",
+		}
+	}
+	return mapPairAttempts(pas), nil
+}
+
 func mapPairAttempts(pas []PairAttempt) []models.PairAttempt {
 	res := make([]models.PairAttempt, len(pas))
 	for i, pa := range pas {
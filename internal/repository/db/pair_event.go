@@ -41,11 +41,16 @@ func (r *Repository) CreateEvent(ctx context.Context, PAID uint64, eventType mod
 		CreatedAt: time.Now(),
 		EventType: unmapPeType(eventType),
 	}
-	res := r.db.WithContext(ctx).Create(&pairEvent)
-	if res.Error != nil {
-		r.logger.Err(res.Error).Msg("can't create event")
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&pairEvent).Error; err != nil {
+			return err
+		}
+		return rollupPairEvent(tx, pairEvent)
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't create event")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't create event",
 		}
 	}
@@ -60,17 +65,65 @@ func (r *Repository) GetLastEvent(ctx context.Context, PAID uint64) (models.Pair
 			return models.PairEvent{}, &errs.CodableError{
 				Code:    errs.CodeNotFound,
 				Message: "no events for this pair attempt",
+				Reason:  errs.ReasonPairEventNotFound,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't get last event")
 		return models.PairEvent{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get last event",
 		}
 	}
 	return mapPairEvent(e), nil
 }
 
+func (r *Repository) GetProfileStats(ctx context.Context, userID uint64, from, to time.Time) (models.ProfileStats, error) {
+	var stats struct {
+		Views int
+		Likes int
+	}
+	res := r.db.WithContext(ctx).Table("pair_events pe").
+		Select(`coalesce(sum(case when (pa.user2 = ? and pe.event_type = ?) or (pa.user1 = ? and pe.event_type = ?) then 1 else 0 end), 0) as views,
+			coalesce(sum(case when (pa.user2 = ? and pe.event_type = ?) or (pa.user1 = ? and pe.event_type = ?) then 1 else 0 end), 0) as likes`,
+			userID, PETypeSentToUser1, userID, PETypeSentToUser2,
+			userID, PETypeUser1Liked, userID, PETypeUser2Liked).
+		Joins("join pair_attempts pa on pa.id = pe.pa_id").
+		Where("(pa.user1 = ? or pa.user2 = ?) and pe.created_at >= ? and pe.created_at < ?", userID, userID, from, to).
+		Scan(&stats)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get profile stats")
+		return models.ProfileStats{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profile stats",
+		}
+	}
+	return models.ProfileStats{
+		Views: stats.Views,
+		Likes: stats.Likes,
+	}, nil
+}
+
+func (r *Repository) GetPairEvents(ctx context.Context, PAID uint64) ([]models.PairEvent, error) {
+	var events []PairEvent
+	res := r.db.WithContext(ctx).Model(&PairEvent{}).Where("pa_id = ?", PAID).Order("created_at, id").Find(&events)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get pair events")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get pair events",
+		}
+	}
+	return mapPairEvents(events), nil
+}
+
+func mapPairEvents(events []PairEvent) []models.PairEvent {
+	res := make([]models.PairEvent, len(events))
+	for i, e := range events {
+		res[i] = mapPairEvent(e)
+	}
+	return res
+}
+
 func mapPairEvent(e PairEvent) models.PairEvent {
 	return models.PairEvent{
 		ID:        e.ID,
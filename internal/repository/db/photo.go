@@ -27,7 +27,7 @@ func (r *Repository) getMaxPhotoOrder(ctx context.Context, userID uint64) (int,
 		}
 		r.logger.Err(res.Error).Msg("can't get max photo order")
 		return 0, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get max photo order",
 		}
 	}
@@ -50,7 +50,7 @@ func (r *Repository) AddPhoto(ctx context.Context, userID uint64, photoKey strin
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't create photo")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't create photo",
 		}
 	}
@@ -63,7 +63,7 @@ func (r *Repository) GetUserPhotos(ctx context.Context, userID uint64) ([]string
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get user photos")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get user photos",
 		}
 	}
@@ -81,11 +81,12 @@ func (r *Repository) DeleteUserPhoto(ctx context.Context, userID uint64, photoKe
 			return &errs.CodableError{
 				Code:    errs.CodeNotFound,
 				Message: "photo not found",
+				Reason:  errs.ReasonPhotoNotFound,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't delete user photo")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't delete user photo",
 		}
 	}
@@ -97,7 +98,7 @@ func (r *Repository) updatePhotoOrder(ctx context.Context, photo string, newOrde
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't update photo order")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't update photo order",
 		}
 	}
@@ -113,3 +114,45 @@ func (r *Repository) ReorderPhotos(ctx context.Context, photos []string) error {
 	}
 	return nil
 }
+
+func (r *Repository) GetPhotoCounts(ctx context.Context) (map[uint64]int, error) {
+	var rows []struct {
+		UserID uint64
+		Count  int
+	}
+	res := r.db.WithContext(ctx).Model(&Photo{}).Select("user_id, count(*) as count").Group("user_id").Scan(&rows)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get photo counts")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get photo counts",
+		}
+	}
+	counts := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *Repository) GetFirstPhotos(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	firstPhotos := make(map[uint64]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return firstPhotos, nil
+	}
+	var photos []Photo
+	res := r.db.WithContext(ctx).Model(&Photo{}).Where("user_id in ?", userIDs).Order("user_id, order_n").Find(&photos)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get first photos")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get first photos",
+		}
+	}
+	for _, photo := range photos {
+		if _, ok := firstPhotos[photo.UserID]; !ok {
+			firstPhotos[photo.UserID] = photo.PhotoKey
+		}
+	}
+	return firstPhotos, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type PreferenceValue struct {
+	UserID    uint64
+	Criterion string
+	Value     string
+}
+
+func (PreferenceValue) TableName() string {
+	return "preference_values"
+}
+
+type PreferenceDealbreaker struct {
+	UserID    uint64
+	Criterion string
+}
+
+func (PreferenceDealbreaker) TableName() string {
+	return "preference_dealbreakers"
+}
+
+type PreferenceGender struct {
+	UserID uint64
+	Gender Gender
+}
+
+func (PreferenceGender) TableName() string {
+	return "preference_genders"
+}
+
+func (r *Repository) fillPreferenceCriteria(ctx context.Context, prefs []models.Preferences) error {
+	if len(prefs) == 0 {
+		return nil
+	}
+	userIDs := make([]uint64, len(prefs))
+	byUser := make(map[uint64]*models.Preferences, len(prefs))
+	for i := range prefs {
+		userIDs[i] = prefs[i].UserID
+		byUser[prefs[i].UserID] = &prefs[i]
+	}
+	var values []PreferenceValue
+	res := r.db.WithContext(ctx).Model(&PreferenceValue{}).Where("user_id in ?", userIDs).
+		Order("criterion, value").Find(&values)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get preference values")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get preference values",
+		}
+	}
+	var dealbreakers []PreferenceDealbreaker
+	res = r.db.WithContext(ctx).Model(&PreferenceDealbreaker{}).Where("user_id in ?", userIDs).
+		Order("criterion").Find(&dealbreakers)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get preference dealbreakers")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get preference dealbreakers",
+		}
+	}
+	var genders []PreferenceGender
+	res = r.db.WithContext(ctx).Model(&PreferenceGender{}).Where("user_id in ?", userIDs).
+		Order("gender").Find(&genders)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get preference genders")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get preference genders",
+		}
+	}
+	for _, g := range genders {
+		gender, err := mapGender(g.Gender)
+		if err != nil {
+			r.logger.Err(err).Msg("can't map preference gender")
+			return &errs.CodableError{
+				Code:    errs.CodeFromCause(err),
+				Message: "can't get preference genders",
+			}
+		}
+		p := byUser[g.UserID]
+		p.Genders = append(p.Genders, gender)
+	}
+	for _, v := range values {
+		p := byUser[v.UserID]
+		switch models.PreferenceCriterion(v.Criterion) {
+		case models.CriterionInterests:
+			p.Interests = append(p.Interests, v.Value)
+		case models.CriterionLanguages:
+			p.Languages = append(p.Languages, v.Value)
+		case models.CriterionRelationshipGoal:
+			p.RelationshipGoals = append(p.RelationshipGoals, models.RelationshipGoal(v.Value))
+		case models.CriterionSmoking:
+			p.Smoking = append(p.Smoking, models.Habit(v.Value))
+		case models.CriterionDrinking:
+			p.Drinking = append(p.Drinking, models.Habit(v.Value))
+		case models.CriterionChildren:
+			p.Children = append(p.Children, models.ChildrenStatus(v.Value))
+		}
+	}
+	for _, d := range dealbreakers {
+		p := byUser[d.UserID]
+		p.Dealbreakers = append(p.Dealbreakers, models.PreferenceCriterion(d.Criterion))
+	}
+	return nil
+}
+
+func unmapPreferenceGenders(prefs models.Preferences) ([]PreferenceGender, error) {
+	genders := make([]PreferenceGender, 0, len(prefs.Genders))
+	for _, g := range prefs.Genders {
+		gender, err := unmapGender(g)
+		if err != nil {
+			return nil, err
+		}
+		genders = append(genders, PreferenceGender{UserID: prefs.UserID, Gender: gender})
+	}
+	return genders, nil
+}
+
+func putPreferenceGenders(tx *gorm.DB, userID uint64, genders []PreferenceGender) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&PreferenceGender{}).Error; err != nil {
+		return err
+	}
+	if len(genders) > 0 {
+		return tx.Create(&genders).Error
+	}
+	return nil
+}
+
+func putPreferenceCriteria(tx *gorm.DB, prefs models.Preferences) error {
+	values := []PreferenceValue{}
+	add := func(criterion models.PreferenceCriterion, value string) {
+		values = append(values, PreferenceValue{
+			UserID:    prefs.UserID,
+			Criterion: string(criterion),
+			Value:     value,
+		})
+	}
+	for _, v := range prefs.Interests {
+		add(models.CriterionInterests, v)
+	}
+	for _, v := range prefs.Languages {
+		add(models.CriterionLanguages, v)
+	}
+	for _, v := range prefs.RelationshipGoals {
+		add(models.CriterionRelationshipGoal, string(v))
+	}
+	for _, v := range prefs.Smoking {
+		add(models.CriterionSmoking, string(v))
+	}
+	for _, v := range prefs.Drinking {
+		add(models.CriterionDrinking, string(v))
+	}
+	for _, v := range prefs.Children {
+		add(models.CriterionChildren, string(v))
+	}
+	if err := tx.Where("user_id = ?", prefs.UserID).Delete(&PreferenceValue{}).Error; err != nil {
+		return err
+	}
+	if len(values) > 0 {
+		if err := tx.Create(&values).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Where("user_id = ?", prefs.UserID).Delete(&PreferenceDealbreaker{}).Error; err != nil {
+		return err
+	}
+	if len(prefs.Dealbreakers) > 0 {
+		dealbreakers := make([]PreferenceDealbreaker, 0, len(prefs.Dealbreakers))
+		for _, d := range prefs.Dealbreakers {
+			dealbreakers = append(dealbreakers, PreferenceDealbreaker{UserID: prefs.UserID, Criterion: string(d)})
+		}
+		if err := tx.Create(&dealbreakers).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
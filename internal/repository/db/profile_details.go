@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProfileDetails struct {
+	UserID           uint64
+	HeightCm         int
+	RelationshipGoal string
+	Smoking          string
+	Drinking         string
+	Children         string
+}
+
+func (ProfileDetails) TableName() string {
+	return "profile_details"
+}
+
+type Interest struct {
+	Tag string
+}
+
+func (Interest) TableName() string {
+	return "interests"
+}
+
+type ProfileInterest struct {
+	UserID uint64
+	Tag    string
+}
+
+func (ProfileInterest) TableName() string {
+	return "profile_interests"
+}
+
+type ProfileLanguage struct {
+	UserID   uint64
+	Language string
+}
+
+func (ProfileLanguage) TableName() string {
+	return "profile_languages"
+}
+
+func (r *Repository) GetInterestTags(ctx context.Context) ([]string, error) {
+	var tags []string
+	res := r.db.WithContext(ctx).Model(&Interest{}).Order("tag").Pluck("tag", &tags)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get interest tags")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get interest tags",
+		}
+	}
+	return tags, nil
+}
+
+func (r *Repository) fillProfileDetails(ctx context.Context, profiles []models.Profile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	ids := make([]uint64, len(profiles))
+	for i, prof := range profiles {
+		ids[i] = prof.UserID
+	}
+	var details []ProfileDetails
+	res := r.db.WithContext(ctx).Model(&ProfileDetails{}).Where("user_id in ?", ids).Find(&details)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get profile details")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profile details",
+		}
+	}
+	var interests []ProfileInterest
+	res = r.db.WithContext(ctx).Model(&ProfileInterest{}).Where("user_id in ?", ids).Order("tag").Find(&interests)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get profile interests")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profile interests",
+		}
+	}
+	var languages []ProfileLanguage
+	res = r.db.WithContext(ctx).Model(&ProfileLanguage{}).Where("user_id in ?", ids).Order("language").Find(&languages)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get profile languages")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profile languages",
+		}
+	}
+	detailsByUser := make(map[uint64]ProfileDetails, len(details))
+	for _, d := range details {
+		detailsByUser[d.UserID] = d
+	}
+	interestsByUser := map[uint64][]string{}
+	for _, in := range interests {
+		interestsByUser[in.UserID] = append(interestsByUser[in.UserID], in.Tag)
+	}
+	languagesByUser := map[uint64][]string{}
+	for _, l := range languages {
+		languagesByUser[l.UserID] = append(languagesByUser[l.UserID], l.Language)
+	}
+	for i := range profiles {
+		d := detailsByUser[profiles[i].UserID]
+		profiles[i].HeightCm = d.HeightCm
+		profiles[i].RelationshipGoal = models.RelationshipGoal(d.RelationshipGoal)
+		profiles[i].Smoking = models.Habit(d.Smoking)
+		profiles[i].Drinking = models.Habit(d.Drinking)
+		profiles[i].Children = models.ChildrenStatus(d.Children)
+		profiles[i].Interests = interestsByUser[profiles[i].UserID]
+		profiles[i].Languages = languagesByUser[profiles[i].UserID]
+	}
+	return nil
+}
+
+func putProfileDetails(tx *gorm.DB, profile models.Profile) error {
+	details := ProfileDetails{
+		UserID:           profile.UserID,
+		HeightCm:         profile.HeightCm,
+		RelationshipGoal: string(profile.RelationshipGoal),
+		Smoking:          string(profile.Smoking),
+		Drinking:         string(profile.Drinking),
+		Children:         string(profile.Children),
+	}
+	if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&details).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("user_id = ?", profile.UserID).Delete(&ProfileInterest{}).Error; err != nil {
+		return err
+	}
+	if len(profile.Interests) > 0 {
+		interests := make([]ProfileInterest, 0, len(profile.Interests))
+		for _, tag := range profile.Interests {
+			interests = append(interests, ProfileInterest{UserID: profile.UserID, Tag: tag})
+		}
+		if err := tx.Create(&interests).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Where("user_id = ?", profile.UserID).Delete(&ProfileLanguage{}).Error; err != nil {
+		return err
+	}
+	if len(profile.Languages) > 0 {
+		languages := make([]ProfileLanguage, 0, len(profile.Languages))
+		for _, lang := range profile.Languages {
+			languages = append(languages, ProfileLanguage{UserID: profile.UserID, Language: lang})
+		}
+		if err := tx.Create(&languages).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
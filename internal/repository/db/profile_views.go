@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProfileViewStat struct {
+	UserID uint64
+	Day    time.Time
+	Views  int
+	Likes  int
+}
+
+func (ProfileViewStat) TableName() string {
+	return "profile_view_stats"
+}
+
+type ProfileViewer struct {
+	ViewedID uint64
+	ViewerID uint64
+	ViewedAt time.Time
+}
+
+func (ProfileViewer) TableName() string {
+	return "profile_viewers"
+}
+
+func rollupPairEvent(tx *gorm.DB, event PairEvent) error {
+	var views, likes int
+	switch event.EventType {
+	case PETypeSentToUser1, PETypeSentToUser2:
+		views = 1
+	case PETypeUser1Liked, PETypeUser2Liked:
+		likes = 1
+	default:
+		return nil
+	}
+	var pa PairAttempt
+	if err := tx.Model(&PairAttempt{}).Where("id = ?", event.PAID).First(&pa).Error; err != nil {
+		return err
+	}
+	viewer, viewed := pa.User1, pa.User2
+	if event.EventType == PETypeSentToUser2 || event.EventType == PETypeUser2Liked {
+		viewer, viewed = pa.User2, pa.User1
+	}
+	stat := ProfileViewStat{
+		UserID: viewed,
+		// UTC, like the DATE() rollup of existing events in migration 18
+		Day:    dateIn(event.CreatedAt, time.UTC),
+		Views:  views,
+		Likes:  likes,
+	}
+	err := tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"views": gorm.Expr("views + ?", views),
+			"likes": gorm.Expr("likes + ?", likes),
+		}),
+	}).Create(&stat).Error
+	if err != nil {
+		return err
+	}
+	if views == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"viewed_at"}),
+	}).Create(&ProfileViewer{
+		ViewedID: viewed,
+		ViewerID: viewer,
+		ViewedAt: event.CreatedAt,
+	}).Error
+}
+
+func (r *Repository) GetDailyProfileStats(ctx context.Context, userID uint64, from time.Time) ([]models.DailyProfileStats, error) {
+	var stats []ProfileViewStat
+	res := r.db.WithContext(ctx).Model(&ProfileViewStat{}).
+		Where("user_id = ? and day >= ?", userID, dateIn(from, time.UTC)).
+		Order("day").Find(&stats)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get daily profile stats")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get daily profile stats",
+		}
+	}
+	result := make([]models.DailyProfileStats, len(stats))
+	for i, s := range stats {
+		result[i] = models.DailyProfileStats{
+			Day: s.Day,
+			ProfileStats: models.ProfileStats{
+				Views: s.Views,
+				Likes: s.Likes,
+			},
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) GetRecentViewers(ctx context.Context, userID uint64, since time.Time, limit int) ([]models.ProfileView, error) {
+	var viewers []ProfileViewer
+	res := r.db.WithContext(ctx).Table("profile_viewers pv").
+		Select("pv.viewed_id, pv.viewer_id, pv.viewed_at").
+		Joins("join users u on u.id = pv.viewer_id").
+		Where("pv.viewed_id = ? and pv.viewed_at >= ?", userID, since).
+		Where("u.show_viewers = ? and u.incognito = ? and u.banned = ?", true, false, false).
+		Order("pv.viewed_at desc").Limit(limit).
+		Scan(&viewers)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get recent viewers")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get recent viewers",
+		}
+	}
+	result := make([]models.ProfileView, len(viewers))
+	for i, v := range viewers {
+		result[i] = models.ProfileView{
+			ViewerID: v.ViewerID,
+			ViewedAt: v.ViewedAt,
+		}
+	}
+	return result, nil
+}
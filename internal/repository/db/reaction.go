@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+type MessageReaction struct {
+	MessageID uint64
+	UserID    uint64
+	Emoji     string
+	CreatedAt time.Time
+}
+
+func (MessageReaction) TableName() string {
+	return "message_reactions"
+}
+
+func (r *Repository) PutReaction(ctx context.Context, msgID, userID uint64, emoji string) error {
+	reaction := MessageReaction{
+		MessageID: msgID,
+		UserID:    userID,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&reaction)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't put reaction")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't put reaction",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) DeleteReaction(ctx context.Context, msgID, userID uint64) error {
+	res := r.db.WithContext(ctx).Where("message_id = ? and user_id = ?", msgID, userID).Delete(&MessageReaction{})
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't delete reaction")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't delete reaction",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) GetReactions(ctx context.Context, msgIDs []uint64) (map[uint64][]models.Reaction, error) {
+	result := map[uint64][]models.Reaction{}
+	if len(msgIDs) == 0 {
+		return result, nil
+	}
+	var reactions []MessageReaction
+	res := r.db.WithContext(ctx).Model(&MessageReaction{}).Where("message_id in ?", msgIDs).Order("created_at").Find(&reactions)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get reactions")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get reactions",
+		}
+	}
+	for _, reaction := range reactions {
+		result[reaction.MessageID] = append(result[reaction.MessageID], models.Reaction{
+			MessageID: reaction.MessageID,
+			UserID:    reaction.UserID,
+			Emoji:     reaction.Emoji,
+			CreatedAt: reaction.CreatedAt,
+		})
+	}
+	return result, nil
+}
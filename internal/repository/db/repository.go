@@ -10,6 +10,13 @@ type Repository struct {
 	logger *zerolog.Logger
 }
 
+// GormConfig is the gorm configuration the repository expects. Driver
+// errors have to be translated for unique-key violations to surface as
+// gorm.ErrDuplicatedKey.
+func GormConfig() *gorm.Config {
+	return &gorm.Config{TranslateError: true}
+}
+
 func New(db *gorm.DB, logger *zerolog.Logger) *Repository {
 	return &Repository{
 		db:     db,
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"gorm.io/gorm"
+)
+
+type messageMatch struct {
+	Message       `gorm:"embedded"`
+	Transcription *string
+}
+
+// SearchMessages runs one select per fulltext index and merges them with a
+// union: MySQL can't use either index when the two MATCHes are ORed.
+func (r *Repository) SearchMessages(ctx context.Context, userID, chatID uint64, terms []string, page models.MessagePage) ([]models.MessageMatch, error) {
+	expr := booleanQuery(terms)
+	texts := r.searchScope(ctx, userID, chatID, page).
+		Select("messages.*, null as transcription").
+		Where("messages.content_type = ?", ContentText).
+		Where("match(messages.payload) against (? in boolean mode)", expr)
+	voices := r.searchScope(ctx, userID, chatID, page).
+		Select("messages.*, transcriptions.transcription").
+		Joins("join transcriptions on transcriptions.message_id = messages.id").
+		Where("messages.content_type = ?", ContentVoice).
+		Where("match(transcriptions.transcription) against (? in boolean mode)", expr)
+	var rows []messageMatch
+	res := r.db.WithContext(ctx).
+		Raw("select * from ((?) union all (?)) as hits order by id desc limit ?", texts, voices, page.Limit).
+		Scan(&rows)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't search messages")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't search messages",
+		}
+	}
+	result := make([]models.MessageMatch, len(rows))
+	for i, row := range rows {
+		result[i] = models.MessageMatch{
+			Message: mapMessage(row.Message),
+			Text:    row.Payload,
+		}
+		if row.ContentType == ContentVoice && row.Transcription != nil {
+			result[i].Text = *row.Transcription
+		}
+	}
+	return result, nil
+}
+
+func (r *Repository) searchScope(ctx context.Context, userID, chatID uint64, page models.MessagePage) *gorm.DB {
+	q := r.db.WithContext(ctx).Table("messages").
+		Joins("join chats on chats.id = messages.chat_id").
+		Where("(chats.user1 = ? or chats.user2 = ?)", userID, userID).
+		Where("messages.deleted_at is null").
+		Where("not exists (select 1 from message_hides h where h.user_id = ? and h.message_id = messages.id)", userID)
+	if chatID != 0 {
+		q = q.Where("messages.chat_id = ?", chatID)
+	}
+	if page.Cursor != 0 {
+		q = q.Where("messages.id < ?", page.Cursor)
+	}
+	return q.Order("messages.id desc").Limit(page.Limit)
+}
+
+func booleanQuery(terms []string) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = "+" + term + "*"
+	}
+	return strings.Join(parts, " ")
+}
@@ -25,7 +25,7 @@ func (r *Repository) GetMessageTranscription(ctx context.Context, msgID uint64)
 	} else if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get message transcription")
 		return "", false, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get message transcription",
 		}
 	}
@@ -40,7 +40,7 @@ func (r *Repository) SaveMessageTranscription(ctx context.Context, msgID uint64,
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't save message transcription")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't save message transcription",
 		}
 	}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TravelMode struct {
+	UserID       uint64
+	LocationLat  float64
+	LocationLon  float64
+	LocationName string
+	Discoverable bool
+	ExpiresAt    time.Time
+}
+
+func (TravelMode) TableName() string {
+	return "travel_modes"
+}
+
+func (r *Repository) GetTravelMode(ctx context.Context, userID uint64) (models.TravelMode, error) {
+	var mode TravelMode
+	res := r.db.WithContext(ctx).Model(&TravelMode{}).Where("user_id = ?", userID).First(&mode)
+	if res.Error != nil && errors.Is(res.Error, gorm.ErrRecordNotFound) {
+		return models.TravelMode{}, nil
+	} else if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get travel mode")
+		return models.TravelMode{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get travel mode",
+		}
+	}
+	return mapTravelMode(mode), nil
+}
+
+func (r *Repository) GetTravelModes(ctx context.Context, userIDs []uint64) (map[uint64]models.TravelMode, error) {
+	result := make(map[uint64]models.TravelMode, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	var modes []TravelMode
+	res := r.db.WithContext(ctx).Model(&TravelMode{}).Where("user_id in ?", userIDs).Find(&modes)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get travel modes")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get travel modes",
+		}
+	}
+	for _, mode := range modes {
+		result[mode.UserID] = mapTravelMode(mode)
+	}
+	return result, nil
+}
+
+func (r *Repository) PutTravelMode(ctx context.Context, mode models.TravelMode) error {
+	m := unmapTravelMode(mode)
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&m)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't put travel mode")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't put travel mode",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) DeleteTravelMode(ctx context.Context, userID uint64) error {
+	res := r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&TravelMode{})
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't delete travel mode")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't delete travel mode",
+		}
+	}
+	return nil
+}
+
+func mapTravelMode(mode TravelMode) models.TravelMode {
+	return models.TravelMode{
+		UserID:       mode.UserID,
+		LocationLat:  mode.LocationLat,
+		LocationLon:  mode.LocationLon,
+		LocationName: mode.LocationName,
+		Discoverable: mode.Discoverable,
+		ExpiresAt:    mode.ExpiresAt,
+	}
+}
+
+func unmapTravelMode(mode models.TravelMode) TravelMode {
+	return TravelMode{
+		UserID:       mode.UserID,
+		LocationLat:  mode.LocationLat,
+		LocationLon:  mode.LocationLon,
+		LocationName: mode.LocationName,
+		Discoverable: mode.Discoverable,
+		ExpiresAt:    mode.ExpiresAt,
+	}
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
@@ -14,14 +16,29 @@ import (
 type Gender string
 
 const (
-	GenderMale   Gender = "male"
-	GenderFemale Gender = "female"
+	GenderMale      Gender = "male"
+	GenderFemale    Gender = "female"
+	GenderNonBinary Gender = "non_binary"
+	GenderCustom    Gender = "custom"
+)
+
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
 )
 
 type User struct {
-	ID          uint64
-	PhoneNumber string
-	PassHash    string
+	ID           uint64
+	PhoneNumber  string
+	PassHash     string
+	Role         UserRole
+	Banned       bool
+	Incognito    bool
+	ShowViewers  bool
+	TokenVersion int
+	LastActiveAt *time.Time
 }
 
 func (User) TableName() string {
@@ -32,7 +49,8 @@ type Profile struct {
 	UserID       uint64
 	Name         string
 	Gender       Gender
-	Age          int
+	CustomGender string
+	Birthdate    time.Time
 	Bio          string
 	LocationLat  float64
 	LocationLon  float64
@@ -47,10 +65,11 @@ type Preferences struct {
 	UserID           uint64
 	MaxAge           int
 	MinAge           int
-	Gender           Gender
 	LocationLat      float64
 	LocationLon      float64
 	LocationRadiusKm float64
+	MinHeightCm      int
+	MaxHeightCm      int
 }
 
 func (Preferences) TableName() string {
@@ -61,18 +80,20 @@ func (r *Repository) CreateUser(ctx context.Context, phoneNumber, passHash strin
 	user := User{
 		PhoneNumber: phoneNumber,
 		PassHash:    passHash,
+		Role:        UserRoleUser,
 	}
 	res := r.db.WithContext(ctx).Create(&user)
 	if res.Error != nil {
 		if errors.Is(res.Error, gorm.ErrDuplicatedKey) {
 			return models.User{}, &errs.CodableError{
-				Code:    errs.CodeInvalidInput,
+				Code:    errs.CodeAlreadyExists,
 				Message: "user with same phone number already exists",
+				Reason:  errs.ReasonPhoneTaken,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't create user")
 		return models.User{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't create user",
 		}
 	}
@@ -85,19 +106,155 @@ func (r *Repository) GetUserByCreds(ctx context.Context, phoneNumber, passHash s
 	if res.Error != nil {
 		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
 			return models.User{}, &errs.CodableError{
-				Code:    errs.CodeNotFound,
+				Code:    errs.CodeUnauthenticated,
 				Message: "invalid phone / password",
+				Reason:  errs.ReasonInvalidCredentials,
+			}
+		}
+		r.logger.Err(res.Error).Msg("can't get user")
+		return models.User{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get user",
+		}
+	}
+	return mapUser(user), nil
+}
+
+func (r *Repository) GetUser(ctx context.Context, userID uint64) (models.User, error) {
+	var user User
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).First(&user)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			return models.User{}, &errs.CodableError{
+				Code:    errs.CodeNotFound,
+				Message: "user not found",
+				Reason:  errs.ReasonUserNotFound,
 			}
 		}
 		r.logger.Err(res.Error).Msg("can't get user")
 		return models.User{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get user",
 		}
 	}
 	return mapUser(user), nil
 }
 
+func (r *Repository) GetUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.User, error) {
+	users := make(map[uint64]models.User, len(userIDs))
+	if len(userIDs) == 0 {
+		return users, nil
+	}
+	var rows []User
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id in ?", userIDs).Find(&rows)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get users")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get users",
+		}
+	}
+	for _, user := range rows {
+		users[user.ID] = mapUser(user)
+	}
+	return users, nil
+}
+
+func (r *Repository) GetUserByPhone(ctx context.Context, phoneNumber string) (models.User, error) {
+	var user User
+	res := r.db.WithContext(ctx).Model(&User{}).Where("phone_number = ?", phoneNumber).First(&user)
+	if res.Error != nil {
+		if errors.Is(res.Error, gorm.ErrRecordNotFound) {
+			return models.User{}, &errs.CodableError{
+				Code:    errs.CodeNotFound,
+				Message: "user not found",
+				Reason:  errs.ReasonUserNotFound,
+			}
+		}
+		r.logger.Err(res.Error).Msg("can't get user by phone")
+		return models.User{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get user by phone",
+		}
+	}
+	return mapUser(user), nil
+}
+
+func (r *Repository) SetUserBanned(ctx context.Context, userID uint64, banned bool) error {
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Update("banned", banned)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't set user banned")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't set user banned",
+		}
+	}
+	if res.RowsAffected == 0 {
+		return &errs.CodableError{
+			Code:    errs.CodeNotFound,
+			Message: "user not found",
+			Reason:  errs.ReasonUserNotFound,
+		}
+	}
+	return nil
+}
+
+func (r *Repository) SetUserIncognito(ctx context.Context, userID uint64, incognito bool) error {
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Update("incognito", incognito)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't set user incognito")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't set user incognito",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) SetUserShowViewers(ctx context.Context, userID uint64, showViewers bool) error {
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Update("show_viewers", showViewers)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't set user show viewers")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't set user show viewers",
+		}
+	}
+	return nil
+}
+
+func (r *Repository) RevokeUserTokens(ctx context.Context, userID uint64) error {
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).
+		Update("token_version", gorm.Expr("token_version + 1"))
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't revoke user tokens")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't revoke user tokens",
+		}
+	}
+	if res.RowsAffected == 0 {
+		return &errs.CodableError{
+			Code:    errs.CodeNotFound,
+			Message: "user not found",
+			Reason:  errs.ReasonUserNotFound,
+		}
+	}
+	return nil
+}
+
+func (r *Repository) TouchUserActivity(ctx context.Context, userID uint64, at time.Time) error {
+	res := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", userID).Update("last_active_at", at)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't touch user activity")
+		return &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't touch user activity",
+		}
+	}
+	return nil
+}
+
 func (r *Repository) GetProfile(ctx context.Context, userID uint64) (models.Profile, error) {
 	var profile Profile
 	res := r.db.WithContext(ctx).Model(&Profile{}).Where("user_id=?", userID).First(&profile)
@@ -106,20 +263,73 @@ func (r *Repository) GetProfile(ctx context.Context, userID uint64) (models.Prof
 	} else if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get profile")
 		return models.Profile{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profile",
+		}
+	}
+	prof, err := mapProfile(profile)
+	if err != nil {
+		r.logger.Err(err).Msg("can't map profile")
+		return models.Profile{}, &errs.CodableError{
+			Code:    errs.CodeFromCause(err),
 			Message: "can't get profile",
 		}
 	}
-	return mapProfile(profile), nil
+	profiles := []models.Profile{prof}
+	if err := r.fillProfileDetails(ctx, profiles); err != nil {
+		return models.Profile{}, err
+	}
+	return profiles[0], nil
 }
 
-func (r *Repository) PutProfile(ctx context.Context, profile models.Profile) error {
-	prof := unmapProfile(profile)
-	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&prof)
+func (r *Repository) GetProfiles(ctx context.Context, userIDs []uint64) (map[uint64]models.Profile, error) {
+	if len(userIDs) == 0 {
+		return map[uint64]models.Profile{}, nil
+	}
+	var profiles []Profile
+	res := r.db.WithContext(ctx).Model(&Profile{}).Where("user_id in ?", userIDs).Find(&profiles)
 	if res.Error != nil {
-		r.logger.Err(res.Error).Msg("can't put profile")
+		r.logger.Err(res.Error).Msg("can't get profiles")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get profiles",
+		}
+	}
+	result := make([]models.Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		prof, err := mapProfile(profile)
+		if err != nil {
+			// one bad row must not fail the whole batch
+			r.logger.Err(err).Uint64("user_id", profile.UserID).Msg("can't map profile, skipping")
+			continue
+		}
+		result = append(result, prof)
+	}
+	if err := r.fillProfileDetails(ctx, result); err != nil {
+		return nil, err
+	}
+	byUser := make(map[uint64]models.Profile, len(result))
+	for _, prof := range result {
+		byUser[prof.UserID] = prof
+	}
+	return byUser, nil
+}
+
+func (r *Repository) PutProfile(ctx context.Context, profile models.Profile) error {
+	prof, err := unmapProfile(profile)
+	if err != nil {
+		return err
+	}
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&prof).Error; err != nil {
+			return err
+		}
+		return putProfileDetails(tx, profile)
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't put profile")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't update profile",
 		}
 	}
@@ -134,20 +344,63 @@ func (r *Repository) GetPreferences(ctx context.Context, userID uint64) (models.
 	} else if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get preferences")
 		return models.Preferences{}, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get preferences",
 		}
 	}
-	return mapPreferences(preferences), nil
+	prefs := []models.Preferences{mapPreferences(preferences)}
+	if err := r.fillPreferenceCriteria(ctx, prefs); err != nil {
+		return models.Preferences{}, err
+	}
+	return prefs[0], nil
+}
+
+func (r *Repository) GetPreferencesByUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.Preferences, error) {
+	if len(userIDs) == 0 {
+		return map[uint64]models.Preferences{}, nil
+	}
+	var preferences []Preferences
+	res := r.db.WithContext(ctx).Model(&Preferences{}).Where("user_id in ?", userIDs).Find(&preferences)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get preferences")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get preferences",
+		}
+	}
+	prefs := make([]models.Preferences, len(preferences))
+	for i, pref := range preferences {
+		prefs[i] = mapPreferences(pref)
+	}
+	if err := r.fillPreferenceCriteria(ctx, prefs); err != nil {
+		return nil, err
+	}
+	byUser := make(map[uint64]models.Preferences, len(prefs))
+	for _, pref := range prefs {
+		byUser[pref.UserID] = pref
+	}
+	return byUser, nil
 }
 
 func (r *Repository) PutPreferences(ctx context.Context, preferences models.Preferences) error {
 	prefs := unmapPreferences(preferences)
-	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&prefs)
-	if res.Error != nil {
-		r.logger.Err(res.Error).Msg("can't put preferences")
+	genders, err := unmapPreferenceGenders(preferences)
+	if err != nil {
+		return err
+	}
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&prefs).Error; err != nil {
+			return err
+		}
+		if err := putPreferenceGenders(tx, preferences.UserID, genders); err != nil {
+			return err
+		}
+		return putPreferenceCriteria(tx, preferences)
+	})
+	if err != nil {
+		r.logger.Err(err).Msg("can't put preferences")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't update preferences",
 		}
 	}
@@ -160,7 +413,7 @@ func (r *Repository) GetAllValidUsers(ctx context.Context) ([]uint64, error) {
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get all profiles")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get all profiles",
 		}
 	}
@@ -169,17 +422,30 @@ func (r *Repository) GetAllValidUsers(ctx context.Context) ([]uint64, error) {
 	if res.Error != nil {
 		r.logger.Err(res.Error).Msg("can't get all preferences")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(res.Error),
 			Message: "can't get all preferences",
 		}
 	}
+	var bannedIDs []uint64
+	res = r.db.WithContext(ctx).Model(&User{}).Where("banned = ?", true).Pluck("id", &bannedIDs)
+	if res.Error != nil {
+		r.logger.Err(res.Error).Msg("can't get banned users")
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFromCause(res.Error),
+			Message: "can't get banned users",
+		}
+	}
+	banned := map[uint64]bool{}
+	for _, id := range bannedIDs {
+		banned[id] = true
+	}
 	hasProfile := map[uint64]bool{}
 	for _, p := range profiles {
 		hasProfile[p.UserID] = true
 	}
 	ids := []uint64{}
 	for _, p := range prefs {
-		if hasProfile[p.UserID] {
+		if hasProfile[p.UserID] && !banned[p.UserID] {
 			ids = append(ids, p.UserID)
 		}
 	}
@@ -187,37 +453,75 @@ func (r *Repository) GetAllValidUsers(ctx context.Context) ([]uint64, error) {
 }
 
 func mapUser(user User) models.User {
+	var lastActiveAt time.Time
+	if user.LastActiveAt != nil {
+		lastActiveAt = *user.LastActiveAt
+	}
 	return models.User{
-		ID:          user.ID,
-		PhoneNumber: user.PhoneNumber,
-		PassHash:    user.PassHash,
+		ID:           user.ID,
+		PhoneNumber:  user.PhoneNumber,
+		PassHash:     user.PassHash,
+		Role:         mapUserRole(user.Role),
+		Banned:       user.Banned,
+		Incognito:    user.Incognito,
+		ShowViewers:  user.ShowViewers,
+		TokenVersion: user.TokenVersion,
+		LastActiveAt: lastActiveAt,
 	}
 }
 
-func mapProfile(prof Profile) models.Profile {
-	return models.Profile{
+func mapUserRole(r UserRole) models.UserRole {
+	switch r {
+	case UserRoleAdmin:
+		return models.UserRoleAdmin
+	default:
+		return models.UserRoleUser
+	}
+}
+
+func mapProfile(prof Profile) (models.Profile, error) {
+	gender, err := mapGender(prof.Gender)
+	if err != nil {
+		return models.Profile{}, err
+	}
+	res := models.Profile{
 		UserID:       prof.UserID,
 		Name:         prof.Name,
-		Gender:       mapGender(prof.Gender),
-		Age:          prof.Age,
+		Gender:       gender,
+		CustomGender: prof.CustomGender,
+		Birthdate:    dateIn(prof.Birthdate, time.UTC),
 		Bio:          prof.Bio,
 		LocationLat:  prof.LocationLat,
 		LocationLon:  prof.LocationLon,
 		LocationName: prof.LocationName,
 	}
+	res.Age = res.AgeAt(time.Now())
+	return res, nil
 }
 
-func unmapProfile(prof models.Profile) Profile {
+func unmapProfile(prof models.Profile) (Profile, error) {
+	gender, err := unmapGender(prof.Gender)
+	if err != nil {
+		return Profile{}, err
+	}
 	return Profile{
 		UserID:       prof.UserID,
 		Name:         prof.Name,
-		Gender:       unmapGender(prof.Gender),
-		Age:          prof.Age,
+		Gender:       gender,
+		CustomGender: prof.CustomGender,
+		Birthdate:    dateIn(prof.Birthdate, time.UTC),
 		Bio:          prof.Bio,
 		LocationLat:  prof.LocationLat,
 		LocationLon:  prof.LocationLon,
 		LocationName: prof.LocationName,
+	}, nil
+}
+
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() {
+		return t
 	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
 }
 
 func mapPreferences(pref Preferences) models.Preferences {
@@ -225,10 +529,11 @@ func mapPreferences(pref Preferences) models.Preferences {
 		UserID:           pref.UserID,
 		MaxAge:           pref.MaxAge,
 		MinAge:           pref.MinAge,
-		Gender:           mapGender(pref.Gender),
 		LocationLat:      pref.LocationLat,
 		LocationLon:      pref.LocationLon,
 		LocationRadiusKm: pref.LocationRadiusKm,
+		MinHeightCm:      pref.MinHeightCm,
+		MaxHeightCm:      pref.MaxHeightCm,
 	}
 }
 
@@ -237,27 +542,41 @@ func unmapPreferences(pref models.Preferences) Preferences {
 		UserID:           pref.UserID,
 		MaxAge:           pref.MaxAge,
 		MinAge:           pref.MinAge,
-		Gender:           unmapGender(pref.Gender),
 		LocationLat:      pref.LocationLat,
 		LocationLon:      pref.LocationLon,
 		LocationRadiusKm: pref.LocationRadiusKm,
+		MinHeightCm:      pref.MinHeightCm,
+		MaxHeightCm:      pref.MaxHeightCm,
 	}
 }
 
-func mapGender(g Gender) models.Gender {
+func mapGender(g Gender) (models.Gender, error) {
 	switch g {
+	case GenderMale:
+		return models.GenderMale, nil
 	case GenderFemale:
-		return models.GenderFemale
-	default:
-		return models.GenderMale
+		return models.GenderFemale, nil
+	case GenderNonBinary:
+		return models.GenderNonBinary, nil
+	case GenderCustom:
+		return models.GenderCustom, nil
 	}
+	return "", fmt.Errorf("unknown gender %q", g)
 }
 
-func unmapGender(g models.Gender) Gender {
+func unmapGender(g models.Gender) (Gender, error) {
 	switch g {
+	case models.GenderMale:
+		return GenderMale, nil
 	case models.GenderFemale:
-		return GenderFemale
-	default:
-		return GenderMale
+		return GenderFemale, nil
+	case models.GenderNonBinary:
+		return GenderNonBinary, nil
+	case models.GenderCustom:
+		return GenderCustom, nil
+	}
+	return "", &errs.CodableError{
+		Code:    errs.CodeInvalidInput,
+		Message: "unknown gender " + string(g),
 	}
 }
@@ -46,7 +46,7 @@ func (fs *FileStorage) saveObj(ctx context.Context, name string, payload []byte)
 	if err != nil {
 		fs.logger.Err(err).Msg("can't save obj")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't save obj",
 		}
 	}
@@ -61,7 +61,7 @@ func (fs *FileStorage) getObj(ctx context.Context, name string) (string, error)
 	if err != nil {
 		fs.logger.Err(err).Msg("can't get obj")
 		return "", &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't get obj",
 		}
 	}
@@ -70,7 +70,7 @@ func (fs *FileStorage) getObj(ctx context.Context, name string) (string, error)
 	if err != nil {
 		fs.logger.Err(err).Msg("can't read obj body")
 		return "", &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't read obj body",
 		}
 	}
@@ -85,7 +85,7 @@ func (fs *FileStorage) delObj(ctx context.Context, name string) error {
 	if err != nil {
 		fs.logger.Err(err).Msg("can't remove obj")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't remove obj",
 		}
 	}
@@ -97,7 +97,7 @@ func (fs *FileStorage) shareObj(ctx context.Context, name string) (string, error
 	if err != nil {
 		fs.logger.Err(err).Msg("can't share obj")
 		return "", &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't share obj",
 		}
 	}
@@ -150,3 +150,11 @@ func (fs *FileStorage) MakeChatVoiceLink(ctx context.Context, key string) (strin
 func (fs *FileStorage) GetChatVoice(ctx context.Context, key string) (string, error) {
 	return fs.getObj(ctx, filepath.Join(chatVoiceDir, key))
 }
+
+func (fs *FileStorage) DelChatPhoto(ctx context.Context, key string) error {
+	return fs.delObj(ctx, filepath.Join(chatPhotoDir, key))
+}
+
+func (fs *FileStorage) DelChatVoice(ctx context.Context, key string) error {
+	return fs.delObj(ctx, filepath.Join(chatVoiceDir, key))
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	admin_api "github.com/mayye4ka/pinder/api/admin"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	metadataContextKey      = "authorization"
+	authorizationTrimPrefix = "Bearer "
+	adminIdContextKey       = "admin_id"
+	requestMetaContextKey   = "request_meta"
+	userAgentMetadataKey    = "user-agent"
+)
+
+type ServerCtrl struct {
+	server     *Server
+	port       int
+	grpcServer *grpc.Server
+}
+
+func New(admin Admin, auth Authenticator, port int) *ServerCtrl {
+	return &ServerCtrl{
+		server: &Server{
+			admin: admin,
+			auth:  auth,
+		},
+		port: port,
+	}
+}
+
+func (c *ServerCtrl) Start(ctx context.Context) error {
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(c.authInterceptor),
+		grpc.Creds(insecure.NewCredentials()),
+	}
+	c.grpcServer = grpc.NewServer(opts...)
+	admin_api.RegisterPinderAdminServer(c.grpcServer, c.server)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", c.port))
+	if err != nil {
+		return errors.Wrap(err, "can't create listener for admin grpc server")
+	}
+	go func() {
+		<-ctx.Done()
+		c.grpcServer.GracefulStop()
+	}()
+	return c.grpcServer.Serve(lis)
+}
+
+func (c *ServerCtrl) Stop(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		c.grpcServer.Stop()
+	}()
+	c.grpcServer.GracefulStop()
+	return nil
+}
+
+func (c *ServerCtrl) authInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = context.WithValue(ctx, requestMetaContextKey, getRequestMeta(ctx))
+	token := c.getTokenFromIncomingContext(ctx)
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	adminId, err := c.server.auth.UnpackAdminToken(ctx, token)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	ctx = context.WithValue(ctx, adminIdContextKey, adminId)
+	return handler(ctx, req)
+}
+
+func getRequestMeta(ctx context.Context) models.RequestMeta {
+	var meta models.RequestMeta
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		meta.IP = p.Addr.String()
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md[userAgentMetadataKey]) > 0 {
+		meta.UserAgent = md[userAgentMetadataKey][0]
+	}
+	return meta
+}
+
+func (c *ServerCtrl) getTokenFromIncomingContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if len(md[metadataContextKey]) != 1 {
+		return ""
+	}
+	return strings.TrimPrefix(md[metadataContextKey][0], authorizationTrimPrefix)
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"time"
+
+	admin_api "github.com/mayye4ka/pinder/api/admin"
+	"github.com/mayye4ka/pinder/internal/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func userToProto(user models.User) *admin_api.User {
+	res := &admin_api.User{
+		Id:          user.ID,
+		PhoneNumber: user.PhoneNumber,
+		Role:        string(user.Role),
+		Banned:      user.Banned,
+		Incognito:   user.Incognito,
+		ShowViewers: user.ShowViewers,
+	}
+	if !user.LastActiveAt.IsZero() {
+		res.LastActiveAt = timestamppb.New(user.LastActiveAt)
+	}
+	return res
+}
+
+func profileToProto(prof models.Profile) *admin_api.Profile {
+	return &admin_api.Profile{
+		Name:         prof.Name,
+		Gender:       string(prof.Gender),
+		CustomGender: prof.CustomGender,
+		Birthdate:    formatDate(prof.Birthdate),
+		Age:          int32(prof.Age),
+		Bio:          prof.Bio,
+		LocationLat:  prof.LocationLat,
+		LocationLon:  prof.LocationLon,
+		LocationName: prof.LocationName,
+
+		HeightCm:         int32(prof.HeightCm),
+		Interests:        prof.Interests,
+		Languages:        prof.Languages,
+		RelationshipGoal: string(prof.RelationshipGoal),
+		Smoking:          string(prof.Smoking),
+		Drinking:         string(prof.Drinking),
+		Children:         string(prof.Children),
+	}
+}
+
+func preferencesToProto(pref models.Preferences) *admin_api.Preferences {
+	return &admin_api.Preferences{
+		MinAge:           int32(pref.MinAge),
+		MaxAge:           int32(pref.MaxAge),
+		LocationLat:      pref.LocationLat,
+		LocationLon:      pref.LocationLon,
+		LocationRadiusKm: pref.LocationRadiusKm,
+
+		MinHeightCm:       int32(pref.MinHeightCm),
+		MaxHeightCm:       int32(pref.MaxHeightCm),
+		Interests:         pref.Interests,
+		Languages:         pref.Languages,
+		RelationshipGoals: stringsToProto(pref.RelationshipGoals),
+		Smoking:           stringsToProto(pref.Smoking),
+		Drinking:          stringsToProto(pref.Drinking),
+		Children:          stringsToProto(pref.Children),
+		Dealbreakers:      stringsToProto(pref.Dealbreakers),
+		Genders:           stringsToProto(pref.Genders),
+	}
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
+
+func stringsToProto[T ~string](values []T) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = string(v)
+	}
+	return res
+}
+
+func photosToProto(photos []models.PhotoShowcase) []*admin_api.Photo {
+	res := make([]*admin_api.Photo, len(photos))
+	for i, photo := range photos {
+		res[i] = &admin_api.Photo{
+			Key:  photo.Key,
+			Link: photo.Link,
+		}
+	}
+	return res
+}
+
+func pairAttemptsToProto(history []models.PairAttemptHistory) []*admin_api.PairAttempt {
+	res := make([]*admin_api.PairAttempt, len(history))
+	for i, h := range history {
+		res[i] = &admin_api.PairAttempt{
+			Id:        h.PairAttempt.ID,
+			User1:     h.PairAttempt.User1,
+			User2:     h.PairAttempt.User2,
+			State:     string(h.PairAttempt.State),
+			CreatedAt: timestamppb.New(h.PairAttempt.CreatedAt),
+			Events:    pairEventsToProto(h.Events),
+		}
+	}
+	return res
+}
+
+func pairEventsToProto(events []models.PairEvent) []*admin_api.PairEvent {
+	res := make([]*admin_api.PairEvent, len(events))
+	for i, e := range events {
+		res[i] = &admin_api.PairEvent{
+			Id:        e.ID,
+			EventType: string(e.EventType),
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+	}
+	return res
+}
+
+func chatsToProto(chats []models.ChatInfo) []*admin_api.Chat {
+	res := make([]*admin_api.Chat, len(chats))
+	for i, chat := range chats {
+		res[i] = &admin_api.Chat{
+			ChatId:       chat.Chat.ID,
+			User1:        chat.Chat.User1,
+			User2:        chat.Chat.User2,
+			MessageCount: int64(chat.Activity.MessageCount),
+		}
+		if !chat.Activity.LastMessageAt.IsZero() {
+			res[i].LastMessageAt = timestamppb.New(chat.Activity.LastMessageAt)
+		}
+	}
+	return res
+}
+
+func auditRecordsToProto(records []models.AuditRecord) []*admin_api.AuditRecord {
+	res := make([]*admin_api.AuditRecord, len(records))
+	for i, rec := range records {
+		res[i] = &admin_api.AuditRecord{
+			Id:        rec.ID,
+			ActorId:   rec.ActorID,
+			Action:    string(rec.Action),
+			TargetId:  rec.TargetID,
+			Details:   rec.Details,
+			Ip:        rec.Meta.IP,
+			UserAgent: rec.Meta.UserAgent,
+			CreatedAt: timestamppb.New(rec.CreatedAt),
+		}
+	}
+	return res
+}
+
+func protoToAuditFilter(filter *admin_api.AuditFilter) models.AuditFilter {
+	if filter == nil {
+		return models.AuditFilter{}
+	}
+	res := models.AuditFilter{
+		ActorID:  filter.ActorId,
+		TargetID: filter.TargetId,
+		Action:   models.AuditAction(filter.Action),
+	}
+	if filter.From != nil {
+		res.From = filter.From.AsTime()
+	}
+	if filter.To != nil {
+		res.To = filter.To.AsTime()
+	}
+	return res
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+
+	admin_api "github.com/mayye4ka/pinder/api/admin"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type Server struct {
+	auth  Authenticator
+	admin Admin
+	admin_api.UnimplementedPinderAdminServer
+}
+
+type Admin interface {
+	FindUserByID(ctx context.Context, userId uint64) (models.User, error)
+	FindUserByPhone(ctx context.Context, phoneNumber string) (models.User, error)
+	GetUserProfile(ctx context.Context, userId uint64) (models.ProfileShowcase, models.Preferences, error)
+	ListPairAttempts(ctx context.Context, userId uint64) ([]models.PairAttemptHistory, error)
+	ListChats(ctx context.Context, userId uint64) ([]models.ChatInfo, error)
+
+	BanUser(ctx context.Context, userId uint64, reason string) error
+	UnbanUser(ctx context.Context, userId uint64, reason string) error
+	ForceLogout(ctx context.Context, userId uint64, reason string) error
+	TakeDownPhoto(ctx context.Context, userId uint64, photoKey string, reason string) error
+
+	ListAuditRecords(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error)
+	ExportAuditRecords(ctx context.Context, filter models.AuditFilter) (models.AuditExport, error)
+}
+
+type Authenticator interface {
+	UnpackAdminToken(ctx context.Context, token string) (uint64, error)
+}
+
+func (s *Server) FindUser(ctx context.Context, req *admin_api.FindUserRequest) (*admin_api.FindUserResponse, error) {
+	var (
+		user models.User
+		err  error
+	)
+	switch q := req.Query.(type) {
+	case *admin_api.FindUserRequest_UserId:
+		user, err = s.admin.FindUserByID(ctx, q.UserId)
+	case *admin_api.FindUserRequest_PhoneNumber:
+		user, err = s.admin.FindUserByPhone(ctx, q.PhoneNumber)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "user id or phone number required")
+	}
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.FindUserResponse{
+		User: userToProto(user),
+	}, nil
+}
+
+func (s *Server) GetUserProfile(ctx context.Context, req *admin_api.GetUserProfileRequest) (*admin_api.GetUserProfileResponse, error) {
+	profile, preferences, err := s.admin.GetUserProfile(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.GetUserProfileResponse{
+		Profile:     profileToProto(profile.Profile),
+		Preferences: preferencesToProto(preferences),
+		Photos:      photosToProto(profile.Photos),
+	}, nil
+}
+
+func (s *Server) ListPairAttempts(ctx context.Context, req *admin_api.ListPairAttemptsRequest) (*admin_api.ListPairAttemptsResponse, error) {
+	history, err := s.admin.ListPairAttempts(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.ListPairAttemptsResponse{
+		PairAttempts: pairAttemptsToProto(history),
+	}, nil
+}
+
+func (s *Server) ListChats(ctx context.Context, req *admin_api.ListChatsRequest) (*admin_api.ListChatsResponse, error) {
+	chats, err := s.admin.ListChats(ctx, req.UserId)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.ListChatsResponse{
+		Chats: chatsToProto(chats),
+	}, nil
+}
+
+func (s *Server) BanUser(ctx context.Context, req *admin_api.BanUserRequest) (*emptypb.Empty, error) {
+	err := s.admin.BanUser(ctx, req.UserId, req.Reason)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) UnbanUser(ctx context.Context, req *admin_api.UnbanUserRequest) (*emptypb.Empty, error) {
+	err := s.admin.UnbanUser(ctx, req.UserId, req.Reason)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ForceLogout(ctx context.Context, req *admin_api.ForceLogoutRequest) (*emptypb.Empty, error) {
+	err := s.admin.ForceLogout(ctx, req.UserId, req.Reason)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) TakeDownPhoto(ctx context.Context, req *admin_api.TakeDownPhotoRequest) (*emptypb.Empty, error) {
+	err := s.admin.TakeDownPhoto(ctx, req.UserId, req.PhotoKey, req.Reason)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) ListAuditRecords(ctx context.Context, req *admin_api.ListAuditRecordsRequest) (*admin_api.ListAuditRecordsResponse, error) {
+	filter := protoToAuditFilter(req.Filter)
+	filter.AfterID = req.AfterId
+	filter.Limit = int(req.Limit)
+	records, err := s.admin.ListAuditRecords(ctx, filter)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.ListAuditRecordsResponse{
+		Records: auditRecordsToProto(records),
+	}, nil
+}
+
+func (s *Server) ExportAuditLog(ctx context.Context, req *admin_api.ExportAuditLogRequest) (*admin_api.ExportAuditLogResponse, error) {
+	filter := protoToAuditFilter(req.Filter)
+	filter.AfterID = req.AfterId
+	export, err := s.admin.ExportAuditRecords(ctx, filter)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &admin_api.ExportAuditLogResponse{
+		Jsonl:       export.Data,
+		NextAfterId: export.NextAfterID,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	app_api "github.com/mayye4ka/pinder/api/app"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AppServer serves the parts of the client API that pinder-api can't express yet.
+type AppServer struct {
+	service Service
+	booster Booster
+	app_api.UnimplementedPinderAppServer
+}
+
+func (s *AppServer) GetOnboardingStatus(ctx context.Context, _ *emptypb.Empty) (*app_api.GetOnboardingStatusResponse, error) {
+	status, err := s.service.GetOnboardingStatus(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.GetOnboardingStatusResponse{
+		Score:           int32(status.Score),
+		MissingRequired: stringsToProto(status.MissingRequired),
+		MissingOptional: stringsToProto(status.MissingOptional),
+	}, nil
+}
+
+func (s *AppServer) SetIncognito(ctx context.Context, req *app_api.SetIncognitoRequest) (*emptypb.Empty, error) {
+	err := s.service.SetIncognito(ctx, req.Incognito)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) SetBirthdate(ctx context.Context, req *app_api.SetBirthdateRequest) (*emptypb.Empty, error) {
+	birthdate, err := time.Parse(time.DateOnly, req.Birthdate)
+	if err != nil {
+		return nil, errs.ToGrpcError(errs.InvalidField("bad birthdate", "birthdate", "expected YYYY-MM-DD"))
+	}
+	err = s.service.SetBirthdate(ctx, birthdate)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) UpdateProfileDetails(ctx context.Context, req *app_api.UpdateProfileDetailsRequest) (*emptypb.Empty, error) {
+	err := s.service.UpdProfileDetails(ctx, protoToProfileDetails(req))
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) GetProfileViewStats(ctx context.Context, req *app_api.GetProfileViewStatsRequest) (*app_api.GetProfileViewStatsResponse, error) {
+	stats, err := s.service.GetProfileViewStats(ctx, int(req.Days))
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.GetProfileViewStatsResponse{
+		Days:  dailyStatsToProto(stats.Days),
+		Total: profileStatsToProto(stats.Total),
+	}, nil
+}
+
+func (s *AppServer) GetRecentViewers(ctx context.Context, _ *emptypb.Empty) (*app_api.GetRecentViewersResponse, error) {
+	viewers, err := s.service.GetRecentViewers(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.GetRecentViewersResponse{
+		Viewers: viewersToProto(viewers),
+	}, nil
+}
+
+func (s *AppServer) SetShowViewers(ctx context.Context, req *app_api.SetShowViewersRequest) (*emptypb.Empty, error) {
+	err := s.service.SetShowViewers(ctx, req.ShowViewers)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) GetPreferences(ctx context.Context, _ *emptypb.Empty) (*app_api.GetPreferencesResponse, error) {
+	preferences, err := s.service.GetPreferences(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.GetPreferencesResponse{
+		Preferences: preferencesToAppProto(preferences),
+	}, nil
+}
+
+func (s *AppServer) UpdatePreferences(ctx context.Context, req *app_api.UpdatePreferencesRequest) (*emptypb.Empty, error) {
+	err := s.service.UpdPreferences(ctx, appProtoToPreferences(req.Preferences))
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) GetTravelMode(ctx context.Context, _ *emptypb.Empty) (*app_api.GetTravelModeResponse, error) {
+	mode, err := s.service.GetTravelMode(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	res := &app_api.GetTravelModeResponse{}
+	if mode.UserID != 0 {
+		res.TravelMode = travelModeToProto(mode)
+	}
+	return res, nil
+}
+
+func (s *AppServer) StartTravelMode(ctx context.Context, req *app_api.StartTravelModeRequest) (*emptypb.Empty, error) {
+	err := s.service.StartTravelMode(ctx, protoToTravelMode(req.TravelMode))
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) StopTravelMode(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	err := s.service.StopTravelMode(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AppServer) NextPartner(ctx context.Context, _ *emptypb.Empty) (*app_api.NextPartnerResponse, error) {
+	candidate, err := s.service.NextPartner(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.NextPartnerResponse{
+		Candidate: profileShowcaseToAppCandidate(candidate),
+	}, nil
+}
+
+func (s *AppServer) Boost(ctx context.Context, _ *emptypb.Empty) (*app_api.BoostResponse, error) {
+	boost, err := s.booster.Boost(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.BoostResponse{
+		Boost: &app_api.Boost{
+			StartedAt: timestamppb.New(boost.StartedAt),
+			EndsAt:    timestamppb.New(boost.EndsAt),
+		},
+	}, nil
+}
+
+func (s *AppServer) ListChats(ctx context.Context, req *app_api.ListChatsRequest) (*app_api.ListChatsResponse, error) {
+	list, err := s.service.ListChats(ctx, models.ChatPage{
+		Cursor: protoToChatCursor(req.Cursor),
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	res := &app_api.ListChatsResponse{
+		Chats: chatsToAppProto(list.Chats),
+	}
+	if !list.NextCursor.IsZero() {
+		res.NextCursor = chatCursorToProto(list.NextCursor)
+	}
+	return res, nil
+}
+
+func (s *AppServer) ListMessages(ctx context.Context, req *app_api.ListMessagesRequest) (*app_api.ListMessagesResponse, error) {
+	list, err := s.service.ListMessages(ctx, req.ChatId, models.MessagePage{
+		Cursor:    req.Cursor,
+		Direction: models.PageDirection(req.Direction),
+		Limit:     int(req.Limit),
+	})
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.ListMessagesResponse{
+		Messages:   messagesToAppProto(list.Messages),
+		NextCursor: list.NextCursor,
+	}, nil
+}
+
+func (s *AppServer) GetMessageEdits(ctx context.Context, req *app_api.GetMessageEditsRequest) (*app_api.GetMessageEditsResponse, error) {
+	edits, err := s.service.GetMessageEdits(ctx, req.MessageId)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.GetMessageEditsResponse{
+		Edits: messageEditsToProto(edits),
+	}, nil
+}
+
+func (s *AppServer) SearchMessages(ctx context.Context, req *app_api.SearchMessagesRequest) (*app_api.SearchMessagesResponse, error) {
+	result, err := s.service.SearchMessages(ctx, req.Query, req.ChatId, models.MessagePage{
+		Cursor: req.Cursor,
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	return &app_api.SearchMessagesResponse{
+		Hits:       searchHitsToProto(result.Hits),
+		NextCursor: result.NextCursor,
+	}, nil
+}
@@ -7,29 +7,48 @@ import (
 	"strings"
 
 	public_api "github.com/mayye4ka/pinder-api/api/go"
+	app_api "github.com/mayye4ka/pinder/api/app"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const (
 	metadataContextKey      = "authorization"
 	authorizationTrimPrefix = "Bearer "
 	userIdContextKey        = "user_id"
+	requestMetaContextKey   = "request_meta"
+	userAgentMetadataKey    = "user-agent"
 )
 
+// anonymousMethods never look at the token, so a stale one left in the
+// client's headers can't lock the user out of logging in again.
+var anonymousMethods = map[string]bool{
+	public_api.Pinder_Register_FullMethodName: true,
+	public_api.Pinder_Login_FullMethodName:    true,
+}
+
 type ServerCtrl struct {
 	server     *Server
+	app        *AppServer
 	port       int
 	grpcServer *grpc.Server
 }
 
-func New(svc Service, auth Authenticator, port int) *ServerCtrl {
+func New(svc Service, auth Authenticator, activity ActivityTracker, booster Booster, port int) *ServerCtrl {
 	return &ServerCtrl{
 		server: &Server{
+			service:  svc,
+			auth:     auth,
+			activity: activity,
+		},
+		app: &AppServer{
 			service: svc,
-			auth:    auth,
+			booster: booster,
 		},
 		port: port,
 	}
@@ -42,6 +61,7 @@ func (c *ServerCtrl) Start(ctx context.Context) error {
 	}
 	c.grpcServer = grpc.NewServer(opts...)
 	public_api.RegisterPinderServer(c.grpcServer, c.server)
+	app_api.RegisterPinderAppServer(c.grpcServer, c.app)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", c.port))
 	if err != nil {
 		return errors.Wrap(err, "can't create listener for grpc server")
@@ -63,21 +83,39 @@ func (c *ServerCtrl) Stop(ctx context.Context) error {
 }
 
 func (c *ServerCtrl) authInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-	userId := c.getUserIdFromIncomingContext(ctx)
+	var userId uint64
+	if !anonymousMethods[info.FullMethod] {
+		var err error
+		userId, err = c.getUserIdFromIncomingContext(ctx)
+		if err != nil {
+			return nil, errs.ToGrpcError(err)
+		}
+	}
+	c.server.activity.Touch(ctx, userId)
 	ctx = context.WithValue(ctx, userIdContextKey, userId)
+	ctx = context.WithValue(ctx, requestMetaContextKey, getRequestMeta(ctx))
 	return handler(ctx, req)
 }
 
-func (c *ServerCtrl) getUserIdFromIncomingContext(ctx context.Context) uint64 {
+func getRequestMeta(ctx context.Context) models.RequestMeta {
+	var meta models.RequestMeta
+	p, ok := peer.FromContext(ctx)
+	if ok && p.Addr != nil {
+		meta.IP = p.Addr.String()
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md[userAgentMetadataKey]) > 0 {
+		meta.UserAgent = md[userAgentMetadataKey][0]
+	}
+	return meta
+}
+
+func (c *ServerCtrl) getUserIdFromIncomingContext(ctx context.Context) (uint64, error) {
 	token := c.getTokenFromIncomingContext(ctx)
 	if token == "" {
-		return 0
-	}
-	userId, err := c.server.auth.UnpackToken(ctx, token)
-	if err != nil {
-		return 0
+		return 0, nil
 	}
-	return userId
+	return c.server.auth.UnpackToken(ctx, token)
 }
 
 func (c *ServerCtrl) getTokenFromIncomingContext(ctx context.Context) string {
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	public_api "github.com/mayye4ka/pinder-api/api/go"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const token = "token"
+
+var (
+	userId   = uint64(123)
+	tokenCtx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataContextKey, authorizationTrimPrefix+token))
+	errStale = &errs.CodableError{
+		Code:    errs.CodeUnauthenticated,
+		Message: "token revoked",
+		Reason:  errs.ReasonTokenRevoked,
+	}
+)
+
+type InterceptorTestSuite struct {
+	suite.Suite
+	authMock     *MockAuthenticator
+	activityMock *MockActivityTracker
+	ctrl         *ServerCtrl
+}
+
+func TestInterceptor(t *testing.T) {
+	suite.Run(t, new(InterceptorTestSuite))
+}
+
+func (s *InterceptorTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.authMock = NewMockAuthenticator(ctrl)
+	s.activityMock = NewMockActivityTracker(ctrl)
+	s.ctrl = New(NewMockService(ctrl), s.authMock, s.activityMock, NewMockBooster(ctrl), 0)
+}
+
+func (s *InterceptorTestSuite) intercept(ctx context.Context, method string) (uint64, error) {
+	var gotUserId uint64
+	_, err := s.ctrl.authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+		gotUserId = ctx.Value(userIdContextKey).(uint64)
+		return nil, nil
+	})
+	return gotUserId, err
+}
+
+func (s *InterceptorTestSuite) TestLoginWithStaleToken() {
+	s.activityMock.EXPECT().Touch(gomock.Any(), uint64(0))
+
+	gotUserId, err := s.intercept(tokenCtx, public_api.Pinder_Login_FullMethodName)
+
+	s.Nil(err)
+	s.Equal(uint64(0), gotUserId)
+}
+
+func (s *InterceptorTestSuite) TestStaleTokenRejected() {
+	s.authMock.EXPECT().UnpackToken(gomock.Any(), token).Return(uint64(0), errStale)
+
+	_, err := s.intercept(tokenCtx, public_api.Pinder_GetProfile_FullMethodName)
+
+	s.Equal(codes.Unauthenticated, status.Code(err))
+}
+
+func (s *InterceptorTestSuite) TestValidToken() {
+	s.authMock.EXPECT().UnpackToken(gomock.Any(), token).Return(userId, nil)
+	s.activityMock.EXPECT().Touch(gomock.Any(), userId)
+
+	gotUserId, err := s.intercept(tokenCtx, public_api.Pinder_GetProfile_FullMethodName)
+
+	s.Nil(err)
+	s.Equal(userId, gotUserId)
+}
@@ -1,7 +1,12 @@
 package server
 
 import (
+	"slices"
+	"time"
+
 	public_api "github.com/mayye4ka/pinder-api/api/go"
+	app_api "github.com/mayye4ka/pinder/api/app"
+	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -9,11 +14,20 @@ import (
 func profileShowcaseToCandidate(prof models.ProfileShowcase) *public_api.Candidate {
 	return &public_api.Candidate{
 		CandidateId: prof.Profile.UserID,
-		Profile:     profileToProto(prof.Profile),
+		Profile:     candidateProfileToProto(prof.Profile),
 		Photos:      photosToLinkList(prof.Photos),
 	}
 }
 
+// candidateProfileToProto leaves out the coordinates: other users only get
+// to see the location name and the distance.
+func candidateProfileToProto(prof models.Profile) *public_api.Profile {
+	res := profileToProto(prof)
+	res.LocationLat = 0
+	res.LocationLon = 0
+	return res
+}
+
 func profileToProto(prof models.Profile) *public_api.Profile {
 	return &public_api.Profile{
 		Name:         prof.Name,
@@ -49,21 +63,48 @@ func photoToProto(photo models.PhotoShowcase) *public_api.Photo {
 	}
 }
 
+// pinder-api only names male and female; the other genders are sent as
+// the next enum values so that legacy clients can tell them apart.
+const (
+	genderNonBinaryProto public_api.GENDER = 2
+	genderCustomProto    public_api.GENDER = 3
+)
+
 func genderToProto(gender models.Gender) public_api.GENDER {
 	switch gender {
 	case models.GenderFemale:
 		return public_api.GENDER_FEMALE
+	case models.GenderNonBinary:
+		return genderNonBinaryProto
+	case models.GenderCustom:
+		return genderCustomProto
 	default:
 		return public_api.GENDER_MAlE
 	}
 }
 
-func protoToGender(gender public_api.GENDER) models.Gender {
+// gendersToProto can only report one of the preferred genders; the full
+// list is available through PinderApp.GetPreferences, and
+// keepPreferenceCriteria keeps it when the client sends this value back.
+func gendersToProto(genders []models.Gender) public_api.GENDER {
+	if len(genders) == 0 {
+		return public_api.GENDER_MAlE
+	}
+	return genderToProto(genders[0])
+}
+
+func protoToGender(gender public_api.GENDER) (models.Gender, error) {
 	switch gender {
+	case public_api.GENDER_MAlE:
+		return models.GenderMale, nil
 	case public_api.GENDER_FEMALE:
-		return models.GenderFemale
+		return models.GenderFemale, nil
+	case genderNonBinaryProto:
+		return models.GenderNonBinary, nil
+	case genderCustomProto:
+		return models.GenderCustom, nil
 	default:
-		return models.GenderMale
+		return "", errs.InvalidField("bad gender", "gender", "unknown gender")
 	}
 }
 
@@ -71,40 +112,90 @@ func preferencesToProto(pref models.Preferences) *public_api.Preferences {
 	return &public_api.Preferences{
 		MaxAge:           int32(pref.MaxAge),
 		MinAge:           int32(pref.MinAge),
-		Gender:           genderToProto(pref.Gender),
+		Gender:           gendersToProto(pref.Genders),
 		LocationLat:      pref.LocationLat,
 		LocationLon:      pref.LocationLon,
 		LocationRadiusKm: pref.LocationRadiusKm,
 	}
 }
 
-func protoToPreferences(pref *public_api.Preferences) models.Preferences {
+func protoToPreferences(pref *public_api.Preferences) (models.Preferences, error) {
 	if pref == nil {
-		return models.Preferences{}
+		return models.Preferences{}, nil
+	}
+	gender, err := protoToGender(pref.Gender)
+	if err != nil {
+		return models.Preferences{}, err
 	}
 	return models.Preferences{
 		MaxAge:           int(pref.MaxAge),
 		MinAge:           int(pref.MinAge),
-		Gender:           protoToGender(pref.Gender),
+		Genders:          []models.Gender{gender},
 		LocationLat:      pref.LocationLat,
 		LocationLon:      pref.LocationLon,
 		LocationRadiusKm: pref.LocationRadiusKm,
+	}, nil
+}
+
+func keepPreferenceCriteria(pref *models.Preferences, current models.Preferences) {
+	if len(pref.Genders) == 1 && slices.Contains(current.Genders, pref.Genders[0]) {
+		pref.Genders = current.Genders
 	}
+	pref.MinHeightCm = current.MinHeightCm
+	pref.MaxHeightCm = current.MaxHeightCm
+	pref.Interests = current.Interests
+	pref.Languages = current.Languages
+	pref.RelationshipGoals = current.RelationshipGoals
+	pref.Smoking = current.Smoking
+	pref.Drinking = current.Drinking
+	pref.Children = current.Children
+	pref.Dealbreakers = current.Dealbreakers
 }
 
-func protoToProfile(prof *public_api.Profile) models.Profile {
+func protoToProfile(prof *public_api.Profile) (models.Profile, error) {
 	if prof == nil {
-		return models.Profile{}
+		return models.Profile{}, nil
+	}
+	gender, err := protoToGender(prof.Gender)
+	if err != nil {
+		return models.Profile{}, err
 	}
 	return models.Profile{
 		Name:         prof.Name,
-		Gender:       protoToGender(prof.Gender),
+		Gender:       gender,
 		Age:          int(prof.Age),
 		Bio:          prof.Bio,
 		LocationLat:  prof.LocationLat,
 		LocationLon:  prof.LocationLon,
 		LocationName: prof.LocationName,
+	}, nil
+}
+
+// keepBirthdate keeps the stored birthdate: pinder-api only carries an age,
+// and deriving a date from it would move the birthday to the day of the
+// edit. The age is only used for a profile that has no birthdate yet;
+// PinderApp.SetBirthdate sets the real one.
+func keepBirthdate(prof *models.Profile, current models.Profile, now time.Time) {
+	if !current.Birthdate.IsZero() {
+		prof.Birthdate = current.Birthdate
+		return
+	}
+	if prof.Age > 0 {
+		prof.Birthdate = time.Date(now.Year()-prof.Age, now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func keepProfileDetails(prof *models.Profile, current models.Profile) {
+	if prof.Gender == current.Gender {
+		prof.CustomGender = current.CustomGender
 	}
+	prof.HeightCm = current.HeightCm
+	prof.Interests = current.Interests
+	prof.Languages = current.Languages
+	prof.RelationshipGoal = current.RelationshipGoal
+	prof.Smoking = current.Smoking
+	prof.Drinking = current.Drinking
+	prof.Children = current.Children
 }
 
 func protoToSwipeVerdict(sv public_api.SWIPE_VERDICT) models.SwipeVerdict {
@@ -171,3 +262,296 @@ func messageToProto(message models.MessageShowcase) *public_api.Message {
 		CreatedAt:   timestamppb.New(message.CreatedAt),
 	}
 }
+
+func profileShowcaseToAppCandidate(prof models.ProfileShowcase) *app_api.Candidate {
+	return &app_api.Candidate{
+		CandidateId:   prof.Profile.UserID,
+		Profile:       profileToAppProto(prof.Profile),
+		Photos:        photosToLinkList(prof.Photos),
+		Compatibility: compatibilityToProto(prof.Compatibility),
+		DistanceKm:    int32(prof.DistanceKm),
+		LastActive:    string(prof.LastActive),
+	}
+}
+
+func profileToAppProto(prof models.Profile) *app_api.Profile {
+	return &app_api.Profile{
+		Name:             prof.Name,
+		Gender:           string(prof.Gender),
+		CustomGender:     prof.CustomGender,
+		Age:              int32(prof.Age),
+		Bio:              prof.Bio,
+		LocationName:     prof.LocationName,
+		HeightCm:         int32(prof.HeightCm),
+		Interests:        prof.Interests,
+		Languages:        prof.Languages,
+		RelationshipGoal: string(prof.RelationshipGoal),
+		Smoking:          string(prof.Smoking),
+		Drinking:         string(prof.Drinking),
+		Children:         string(prof.Children),
+	}
+}
+
+func preferencesToAppProto(pref models.Preferences) *app_api.Preferences {
+	return &app_api.Preferences{
+		MinAge:            int32(pref.MinAge),
+		MaxAge:            int32(pref.MaxAge),
+		Genders:           stringsToProto(pref.Genders),
+		LocationLat:       pref.LocationLat,
+		LocationLon:       pref.LocationLon,
+		LocationRadiusKm:  pref.LocationRadiusKm,
+		MinHeightCm:       int32(pref.MinHeightCm),
+		MaxHeightCm:       int32(pref.MaxHeightCm),
+		Interests:         pref.Interests,
+		Languages:         pref.Languages,
+		RelationshipGoals: stringsToProto(pref.RelationshipGoals),
+		Smoking:           stringsToProto(pref.Smoking),
+		Drinking:          stringsToProto(pref.Drinking),
+		Children:          stringsToProto(pref.Children),
+		Dealbreakers:      stringsToProto(pref.Dealbreakers),
+	}
+}
+
+func appProtoToPreferences(pref *app_api.Preferences) models.Preferences {
+	if pref == nil {
+		return models.Preferences{}
+	}
+	return models.Preferences{
+		MinAge:            int(pref.MinAge),
+		MaxAge:            int(pref.MaxAge),
+		Genders:           protoToStrings[models.Gender](pref.Genders),
+		LocationLat:       pref.LocationLat,
+		LocationLon:       pref.LocationLon,
+		LocationRadiusKm:  pref.LocationRadiusKm,
+		MinHeightCm:       int(pref.MinHeightCm),
+		MaxHeightCm:       int(pref.MaxHeightCm),
+		Interests:         pref.Interests,
+		Languages:         pref.Languages,
+		RelationshipGoals: protoToStrings[models.RelationshipGoal](pref.RelationshipGoals),
+		Smoking:           protoToStrings[models.Habit](pref.Smoking),
+		Drinking:          protoToStrings[models.Habit](pref.Drinking),
+		Children:          protoToStrings[models.ChildrenStatus](pref.Children),
+		Dealbreakers:      protoToStrings[models.PreferenceCriterion](pref.Dealbreakers),
+	}
+}
+
+func protoToProfileDetails(req *app_api.UpdateProfileDetailsRequest) models.ProfileDetails {
+	return models.ProfileDetails{
+		HeightCm:         int(req.HeightCm),
+		Interests:        req.Interests,
+		Languages:        req.Languages,
+		RelationshipGoal: models.RelationshipGoal(req.RelationshipGoal),
+		Smoking:          models.Habit(req.Smoking),
+		Drinking:         models.Habit(req.Drinking),
+		Children:         models.ChildrenStatus(req.Children),
+	}
+}
+
+func travelModeToProto(mode models.TravelMode) *app_api.TravelMode {
+	return &app_api.TravelMode{
+		LocationLat:  mode.LocationLat,
+		LocationLon:  mode.LocationLon,
+		LocationName: mode.LocationName,
+		Discoverable: mode.Discoverable,
+		ExpiresAt:    timestamppb.New(mode.ExpiresAt),
+	}
+}
+
+func protoToTravelMode(mode *app_api.TravelMode) models.TravelMode {
+	if mode == nil {
+		return models.TravelMode{}
+	}
+	return models.TravelMode{
+		LocationLat:  mode.LocationLat,
+		LocationLon:  mode.LocationLon,
+		LocationName: mode.LocationName,
+		Discoverable: mode.Discoverable,
+		ExpiresAt:    mode.ExpiresAt.AsTime(),
+	}
+}
+
+func profileStatsToProto(stats models.ProfileStats) *app_api.ProfileStats {
+	return &app_api.ProfileStats{
+		Views: int32(stats.Views),
+		Likes: int32(stats.Likes),
+	}
+}
+
+func dailyStatsToProto(days []models.DailyProfileStats) []*app_api.DailyProfileStats {
+	res := make([]*app_api.DailyProfileStats, len(days))
+	for i, day := range days {
+		res[i] = &app_api.DailyProfileStats{
+			Day:   day.Day.Format(time.DateOnly),
+			Stats: profileStatsToProto(day.ProfileStats),
+		}
+	}
+	return res
+}
+
+func viewersToProto(viewers []models.ProfileViewer) []*app_api.ProfileViewer {
+	res := make([]*app_api.ProfileViewer, len(viewers))
+	for i, viewer := range viewers {
+		res[i] = &app_api.ProfileViewer{
+			UserId:   viewer.UserID,
+			Name:     viewer.Name,
+			Photo:    viewer.Photo,
+			ViewedAt: timestamppb.New(viewer.ViewedAt),
+		}
+	}
+	return res
+}
+
+func chatsToAppProto(chats []models.ChatShowcase) []*app_api.Chat {
+	res := make([]*app_api.Chat, len(chats))
+	for i, chat := range chats {
+		res[i] = chatToAppProto(chat)
+	}
+	return res
+}
+
+func chatToAppProto(chat models.ChatShowcase) *app_api.Chat {
+	res := &app_api.Chat{
+		ChatId:        chat.ID,
+		Name:          chat.Name,
+		Photo:         chat.Photo,
+		Compatibility: compatibilityToProto(chat.Compatibility),
+		DistanceKm:    int32(chat.DistanceKm),
+		LastActive:    string(chat.LastActive),
+		UnreadCount:   int32(chat.UnreadCount),
+	}
+	if chat.LastMessage != nil {
+		res.LastMessage = messagePreviewToProto(*chat.LastMessage)
+	}
+	if !chat.LastActivityAt.IsZero() {
+		res.LastActivityAt = timestamppb.New(chat.LastActivityAt)
+	}
+	return res
+}
+
+func messagePreviewToProto(preview models.MessagePreview) *app_api.MessagePreview {
+	return &app_api.MessagePreview{
+		Id:          preview.ID,
+		SentByMe:    preview.SentByMe,
+		ContentType: string(preview.ContentType),
+		Preview:     preview.Preview,
+		CreatedAt:   timestamppb.New(preview.CreatedAt),
+		Deleted:     preview.Deleted,
+	}
+}
+
+func chatCursorToProto(cursor models.ChatCursor) *app_api.ChatCursor {
+	return &app_api.ChatCursor{
+		ActivityAt: timestamppb.New(cursor.ActivityAt),
+		ChatId:     cursor.ChatID,
+	}
+}
+
+func protoToChatCursor(cursor *app_api.ChatCursor) models.ChatCursor {
+	if cursor == nil {
+		return models.ChatCursor{}
+	}
+	return models.ChatCursor{
+		ActivityAt: cursor.ActivityAt.AsTime(),
+		ChatID:     cursor.ChatId,
+	}
+}
+
+func messagesToAppProto(messages []models.MessageShowcase) []*app_api.Message {
+	res := make([]*app_api.Message, len(messages))
+	for i, message := range messages {
+		res[i] = messageToAppProto(message)
+	}
+	return res
+}
+
+func messageToAppProto(message models.MessageShowcase) *app_api.Message {
+	return &app_api.Message{
+		Id:          message.ID,
+		SentByMe:    message.SentByMe,
+		ContentType: string(message.ContentType),
+		Payload:     message.Payload,
+		CreatedAt:   timestamppb.New(message.CreatedAt),
+		Read:        message.Read,
+		Edited:      message.Edited,
+		Deleted:     message.Deleted,
+		Reactions:   reactionsToProto(message.Reactions),
+		ReplyTo:     replyToToProto(message.ReplyTo),
+	}
+}
+
+func replyToToProto(preview *models.MessagePreview) *app_api.MessagePreview {
+	if preview == nil {
+		return nil
+	}
+	return messagePreviewToProto(*preview)
+}
+
+func reactionsToProto(reactions []models.ReactionShowcase) []*app_api.Reaction {
+	res := make([]*app_api.Reaction, len(reactions))
+	for i, reaction := range reactions {
+		res[i] = &app_api.Reaction{
+			Emoji: reaction.Emoji,
+			ByMe:  reaction.ByMe,
+		}
+	}
+	return res
+}
+
+func messageEditsToProto(edits []models.MessageEdit) []*app_api.MessageEdit {
+	res := make([]*app_api.MessageEdit, len(edits))
+	for i, edit := range edits {
+		res[i] = &app_api.MessageEdit{
+			Payload:  edit.Payload,
+			EditedAt: timestamppb.New(edit.EditedAt),
+		}
+	}
+	return res
+}
+
+func searchHitsToProto(hits []models.SearchHit) []*app_api.SearchHit {
+	res := make([]*app_api.SearchHit, len(hits))
+	for i, hit := range hits {
+		highlights := make([]*app_api.TextRange, len(hit.Highlights))
+		for j, r := range hit.Highlights {
+			highlights[j] = &app_api.TextRange{
+				Start: int32(r.Start),
+				End:   int32(r.End),
+			}
+		}
+		res[i] = &app_api.SearchHit{
+			ChatId:      hit.ChatID,
+			MessageId:   hit.MessageID,
+			SentByMe:    hit.SentByMe,
+			ContentType: string(hit.ContentType),
+			Snippet:     hit.Snippet,
+			Highlights:  highlights,
+			CreatedAt:   timestamppb.New(hit.CreatedAt),
+		}
+	}
+	return res
+}
+
+func stringsToProto[T ~string](values []T) []string {
+	res := make([]string, len(values))
+	for i, v := range values {
+		res[i] = string(v)
+	}
+	return res
+}
+
+func protoToStrings[T ~string](values []string) []T {
+	res := make([]T, len(values))
+	for i, v := range values {
+		res[i] = T(v)
+	}
+	return res
+}
+
+func compatibilityToProto(c models.Compatibility) *app_api.Compatibility {
+	return &app_api.Compatibility{
+		Score:           int32(c.Score),
+		SharedInterests: c.SharedInterests,
+		SharedLanguages: c.SharedLanguages,
+		GoalsAligned:    c.GoalsAligned,
+	}
+}
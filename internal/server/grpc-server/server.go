@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	public_api "github.com/mayye4ka/pinder-api/api/go"
 	"github.com/mayye4ka/pinder/internal/errs"
@@ -11,31 +12,59 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+const (
+	listChatsPageSize    = 100
+	listMessagesPageSize = 100
+)
+
 type Server struct {
-	auth    Authenticator
-	service Service
+	auth     Authenticator
+	service  Service
+	activity ActivityTracker
 	public_api.UnimplementedPinderServer
 }
 
 type Service interface {
 	GetProfile(ctx context.Context) (models.ProfileShowcase, error)
 	UpdProfile(ctx context.Context, newProfile models.Profile) error
+	UpdProfileDetails(ctx context.Context, details models.ProfileDetails) error
+	SetBirthdate(ctx context.Context, birthdate time.Time) error
 	GetPreferences(ctx context.Context) (models.Preferences, error)
 	UpdPreferences(ctx context.Context, newPreferences models.Preferences) error
+	SetIncognito(ctx context.Context, incognito bool) error
+	GetOnboardingStatus(ctx context.Context) (models.Completeness, error)
+
+	GetProfileViewStats(ctx context.Context, days int) (models.ProfileViewStats, error)
+	GetRecentViewers(ctx context.Context) ([]models.ProfileViewer, error)
+	SetShowViewers(ctx context.Context, showViewers bool) error
 
 	AddPhoto(ctx context.Context, photo string) error
 	DeletePhoto(ctx context.Context, photoKey string) error
 	ReorderPhotos(ctx context.Context, newOrder []string) error
 
+	GetTravelMode(ctx context.Context) (models.TravelMode, error)
+	StartTravelMode(ctx context.Context, mode models.TravelMode) error
+	StopTravelMode(ctx context.Context) error
+
 	NextPartner(ctx context.Context) (models.ProfileShowcase, error)
 	Swipe(ctx context.Context, candidateId uint64, swipeVerdict models.SwipeVerdict) error
 
-	ListChats(ctx context.Context) ([]models.ChatShowcase, error)
-	ListMessages(ctx context.Context, chatId uint64) ([]models.MessageShowcase, error)
-	SendMessage(ctx context.Context, chatId uint64, contentType models.MsgContentType, payload string) error
+	ListChats(ctx context.Context, page models.ChatPage) (models.ChatList, error)
+	ListMessages(ctx context.Context, chatId uint64, page models.MessagePage) (models.MessageList, error)
+	SendMessage(ctx context.Context, msg models.OutgoingMessage) (uint64, error)
+	GetMessageEdits(ctx context.Context, messageId uint64) ([]models.MessageEdit, error)
+	SearchMessages(ctx context.Context, query string, chatId uint64, page models.MessagePage) (models.SearchResult, error)
 	GetTextFromVoice(ctx context.Context, msgId uint64) (string, bool, error)
 }
 
+type Booster interface {
+	Boost(ctx context.Context) (models.Boost, error)
+}
+
+type ActivityTracker interface {
+	Touch(ctx context.Context, userID uint64)
+}
+
 type Authenticator interface {
 	UnpackToken(ctx context.Context, token string) (uint64, error)
 	Register(ctx context.Context, phone, password string) (string, error)
@@ -94,7 +123,17 @@ func (s *Server) GetPreferences(ctx context.Context, _ *emptypb.Empty) (*public_
 }
 
 func (s *Server) UpdateProfile(ctx context.Context, req *public_api.UpdateProfileRequest) (*emptypb.Empty, error) {
-	err := s.service.UpdProfile(ctx, protoToProfile(req.NewProfile))
+	current, err := s.service.GetProfile(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	newProfile, err := protoToProfile(req.NewProfile)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	keepBirthdate(&newProfile, current.Profile, time.Now())
+	keepProfileDetails(&newProfile, current.Profile)
+	err = s.service.UpdProfile(ctx, newProfile)
 	if err != nil {
 		return nil, errs.ToGrpcError(err)
 	}
@@ -102,7 +141,16 @@ func (s *Server) UpdateProfile(ctx context.Context, req *public_api.UpdateProfil
 }
 
 func (s *Server) UpdatePreferences(ctx context.Context, req *public_api.UpdatePreferencesRequest) (*emptypb.Empty, error) {
-	err := s.service.UpdPreferences(ctx, protoToPreferences(req.NewPreferences))
+	current, err := s.service.GetPreferences(ctx)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	newPreferences, err := protoToPreferences(req.NewPreferences)
+	if err != nil {
+		return nil, errs.ToGrpcError(err)
+	}
+	keepPreferenceCriteria(&newPreferences, current)
+	err = s.service.UpdPreferences(ctx, newPreferences)
 	if err != nil {
 		return nil, errs.ToGrpcError(err)
 	}
@@ -151,28 +199,43 @@ func (s *Server) Swipe(ctx context.Context, req *public_api.SwipeRequest) (*empt
 	return &emptypb.Empty{}, nil
 }
 
+// ListChats returns the most recently active page of chats. pinder-api's
+// request has no cursor, so the rest is only reachable through
+// PinderApp.ListChats.
 func (s *Server) ListChats(ctx context.Context, _ *emptypb.Empty) (*public_api.ListChatsResponse, error) {
-	chats, err := s.service.ListChats(ctx)
+	list, err := s.service.ListChats(ctx, models.ChatPage{
+		Limit: listChatsPageSize,
+	})
 	if err != nil {
 		return nil, errs.ToGrpcError(err)
 	}
 	return &public_api.ListChatsResponse{
-		Chats: chatsToProto(chats),
+		Chats: chatsToProto(list.Chats),
 	}, nil
 }
 
+// ListMessages returns the latest page of the chat. pinder-api's request
+// has no cursor, so older messages are only reachable through
+// PinderApp.ListMessages.
 func (s *Server) ListMessages(ctx context.Context, req *public_api.ListMessagesRequest) (*public_api.ListMessagesResponse, error) {
-	messages, err := s.service.ListMessages(ctx, req.ChatId)
+	list, err := s.service.ListMessages(ctx, req.ChatId, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     listMessagesPageSize,
+	})
 	if err != nil {
 		return nil, errs.ToGrpcError(err)
 	}
 	return &public_api.ListMessagesResponse{
-		Messages: messagesToProto(messages),
+		Messages: messagesToProto(list.Messages),
 	}, nil
 }
 
 func (s *Server) SendMessage(ctx context.Context, req *public_api.SendMessageRequest) (*emptypb.Empty, error) {
-	err := s.service.SendMessage(ctx, req.ChatId, protoToMsgContentType(req.ContentType), string(req.Payload))
+	_, err := s.service.SendMessage(ctx, models.OutgoingMessage{
+		ChatID:      req.ChatId,
+		ContentType: protoToMsgContentType(req.ContentType),
+		Payload:     string(req.Payload),
+	})
 	if err != nil {
 		return nil, errs.ToGrpcError(err)
 	}
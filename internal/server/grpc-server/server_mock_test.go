@@ -0,0 +1,547 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/server/grpc-server/server.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/server/grpc-server/server.go -destination internal/server/grpc-server/server_mock_test.go -package server
+//
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/mayye4ka/pinder/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// AddPhoto mocks base method.
+func (m *MockService) AddPhoto(ctx context.Context, photo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPhoto", ctx, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPhoto indicates an expected call of AddPhoto.
+func (mr *MockServiceMockRecorder) AddPhoto(ctx, photo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPhoto", reflect.TypeOf((*MockService)(nil).AddPhoto), ctx, photo)
+}
+
+// DeletePhoto mocks base method.
+func (m *MockService) DeletePhoto(ctx context.Context, photoKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhoto", ctx, photoKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePhoto indicates an expected call of DeletePhoto.
+func (mr *MockServiceMockRecorder) DeletePhoto(ctx, photoKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhoto", reflect.TypeOf((*MockService)(nil).DeletePhoto), ctx, photoKey)
+}
+
+// GetMessageEdits mocks base method.
+func (m *MockService) GetMessageEdits(ctx context.Context, messageId uint64) ([]models.MessageEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageEdits", ctx, messageId)
+	ret0, _ := ret[0].([]models.MessageEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageEdits indicates an expected call of GetMessageEdits.
+func (mr *MockServiceMockRecorder) GetMessageEdits(ctx, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageEdits", reflect.TypeOf((*MockService)(nil).GetMessageEdits), ctx, messageId)
+}
+
+// GetOnboardingStatus mocks base method.
+func (m *MockService) GetOnboardingStatus(ctx context.Context) (models.Completeness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnboardingStatus", ctx)
+	ret0, _ := ret[0].(models.Completeness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnboardingStatus indicates an expected call of GetOnboardingStatus.
+func (mr *MockServiceMockRecorder) GetOnboardingStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnboardingStatus", reflect.TypeOf((*MockService)(nil).GetOnboardingStatus), ctx)
+}
+
+// GetPreferences mocks base method.
+func (m *MockService) GetPreferences(ctx context.Context) (models.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx)
+	ret0, _ := ret[0].(models.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockServiceMockRecorder) GetPreferences(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockService)(nil).GetPreferences), ctx)
+}
+
+// GetProfile mocks base method.
+func (m *MockService) GetProfile(ctx context.Context) (models.ProfileShowcase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx)
+	ret0, _ := ret[0].(models.ProfileShowcase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockServiceMockRecorder) GetProfile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockService)(nil).GetProfile), ctx)
+}
+
+// GetProfileViewStats mocks base method.
+func (m *MockService) GetProfileViewStats(ctx context.Context, days int) (models.ProfileViewStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileViewStats", ctx, days)
+	ret0, _ := ret[0].(models.ProfileViewStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileViewStats indicates an expected call of GetProfileViewStats.
+func (mr *MockServiceMockRecorder) GetProfileViewStats(ctx, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileViewStats", reflect.TypeOf((*MockService)(nil).GetProfileViewStats), ctx, days)
+}
+
+// GetRecentViewers mocks base method.
+func (m *MockService) GetRecentViewers(ctx context.Context) ([]models.ProfileViewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentViewers", ctx)
+	ret0, _ := ret[0].([]models.ProfileViewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentViewers indicates an expected call of GetRecentViewers.
+func (mr *MockServiceMockRecorder) GetRecentViewers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentViewers", reflect.TypeOf((*MockService)(nil).GetRecentViewers), ctx)
+}
+
+// GetTextFromVoice mocks base method.
+func (m *MockService) GetTextFromVoice(ctx context.Context, msgId uint64) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTextFromVoice", ctx, msgId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTextFromVoice indicates an expected call of GetTextFromVoice.
+func (mr *MockServiceMockRecorder) GetTextFromVoice(ctx, msgId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTextFromVoice", reflect.TypeOf((*MockService)(nil).GetTextFromVoice), ctx, msgId)
+}
+
+// GetTravelMode mocks base method.
+func (m *MockService) GetTravelMode(ctx context.Context) (models.TravelMode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTravelMode", ctx)
+	ret0, _ := ret[0].(models.TravelMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTravelMode indicates an expected call of GetTravelMode.
+func (mr *MockServiceMockRecorder) GetTravelMode(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTravelMode", reflect.TypeOf((*MockService)(nil).GetTravelMode), ctx)
+}
+
+// ListChats mocks base method.
+func (m *MockService) ListChats(ctx context.Context, page models.ChatPage) (models.ChatList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChats", ctx, page)
+	ret0, _ := ret[0].(models.ChatList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChats indicates an expected call of ListChats.
+func (mr *MockServiceMockRecorder) ListChats(ctx, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChats", reflect.TypeOf((*MockService)(nil).ListChats), ctx, page)
+}
+
+// ListMessages mocks base method.
+func (m *MockService) ListMessages(ctx context.Context, chatId uint64, page models.MessagePage) (models.MessageList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", ctx, chatId, page)
+	ret0, _ := ret[0].(models.MessageList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockServiceMockRecorder) ListMessages(ctx, chatId, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockService)(nil).ListMessages), ctx, chatId, page)
+}
+
+// NextPartner mocks base method.
+func (m *MockService) NextPartner(ctx context.Context) (models.ProfileShowcase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextPartner", ctx)
+	ret0, _ := ret[0].(models.ProfileShowcase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextPartner indicates an expected call of NextPartner.
+func (mr *MockServiceMockRecorder) NextPartner(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextPartner", reflect.TypeOf((*MockService)(nil).NextPartner), ctx)
+}
+
+// ReorderPhotos mocks base method.
+func (m *MockService) ReorderPhotos(ctx context.Context, newOrder []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderPhotos", ctx, newOrder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderPhotos indicates an expected call of ReorderPhotos.
+func (mr *MockServiceMockRecorder) ReorderPhotos(ctx, newOrder any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderPhotos", reflect.TypeOf((*MockService)(nil).ReorderPhotos), ctx, newOrder)
+}
+
+// SearchMessages mocks base method.
+func (m *MockService) SearchMessages(ctx context.Context, query string, chatId uint64, page models.MessagePage) (models.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, query, chatId, page)
+	ret0, _ := ret[0].(models.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockServiceMockRecorder) SearchMessages(ctx, query, chatId, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockService)(nil).SearchMessages), ctx, query, chatId, page)
+}
+
+// SendMessage mocks base method.
+func (m *MockService) SendMessage(ctx context.Context, msg models.OutgoingMessage) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockServiceMockRecorder) SendMessage(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockService)(nil).SendMessage), ctx, msg)
+}
+
+// SetBirthdate mocks base method.
+func (m *MockService) SetBirthdate(ctx context.Context, birthdate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBirthdate", ctx, birthdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBirthdate indicates an expected call of SetBirthdate.
+func (mr *MockServiceMockRecorder) SetBirthdate(ctx, birthdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBirthdate", reflect.TypeOf((*MockService)(nil).SetBirthdate), ctx, birthdate)
+}
+
+// SetIncognito mocks base method.
+func (m *MockService) SetIncognito(ctx context.Context, incognito bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIncognito", ctx, incognito)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIncognito indicates an expected call of SetIncognito.
+func (mr *MockServiceMockRecorder) SetIncognito(ctx, incognito any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIncognito", reflect.TypeOf((*MockService)(nil).SetIncognito), ctx, incognito)
+}
+
+// SetShowViewers mocks base method.
+func (m *MockService) SetShowViewers(ctx context.Context, showViewers bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShowViewers", ctx, showViewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetShowViewers indicates an expected call of SetShowViewers.
+func (mr *MockServiceMockRecorder) SetShowViewers(ctx, showViewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShowViewers", reflect.TypeOf((*MockService)(nil).SetShowViewers), ctx, showViewers)
+}
+
+// StartTravelMode mocks base method.
+func (m *MockService) StartTravelMode(ctx context.Context, mode models.TravelMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTravelMode", ctx, mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTravelMode indicates an expected call of StartTravelMode.
+func (mr *MockServiceMockRecorder) StartTravelMode(ctx, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTravelMode", reflect.TypeOf((*MockService)(nil).StartTravelMode), ctx, mode)
+}
+
+// StopTravelMode mocks base method.
+func (m *MockService) StopTravelMode(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTravelMode", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTravelMode indicates an expected call of StopTravelMode.
+func (mr *MockServiceMockRecorder) StopTravelMode(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTravelMode", reflect.TypeOf((*MockService)(nil).StopTravelMode), ctx)
+}
+
+// Swipe mocks base method.
+func (m *MockService) Swipe(ctx context.Context, candidateId uint64, swipeVerdict models.SwipeVerdict) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Swipe", ctx, candidateId, swipeVerdict)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Swipe indicates an expected call of Swipe.
+func (mr *MockServiceMockRecorder) Swipe(ctx, candidateId, swipeVerdict any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Swipe", reflect.TypeOf((*MockService)(nil).Swipe), ctx, candidateId, swipeVerdict)
+}
+
+// UpdPreferences mocks base method.
+func (m *MockService) UpdPreferences(ctx context.Context, newPreferences models.Preferences) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdPreferences", ctx, newPreferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdPreferences indicates an expected call of UpdPreferences.
+func (mr *MockServiceMockRecorder) UpdPreferences(ctx, newPreferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdPreferences", reflect.TypeOf((*MockService)(nil).UpdPreferences), ctx, newPreferences)
+}
+
+// UpdProfile mocks base method.
+func (m *MockService) UpdProfile(ctx context.Context, newProfile models.Profile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdProfile", ctx, newProfile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdProfile indicates an expected call of UpdProfile.
+func (mr *MockServiceMockRecorder) UpdProfile(ctx, newProfile any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdProfile", reflect.TypeOf((*MockService)(nil).UpdProfile), ctx, newProfile)
+}
+
+// UpdProfileDetails mocks base method.
+func (m *MockService) UpdProfileDetails(ctx context.Context, details models.ProfileDetails) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdProfileDetails", ctx, details)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdProfileDetails indicates an expected call of UpdProfileDetails.
+func (mr *MockServiceMockRecorder) UpdProfileDetails(ctx, details any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdProfileDetails", reflect.TypeOf((*MockService)(nil).UpdProfileDetails), ctx, details)
+}
+
+// MockBooster is a mock of Booster interface.
+type MockBooster struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoosterMockRecorder
+}
+
+// MockBoosterMockRecorder is the mock recorder for MockBooster.
+type MockBoosterMockRecorder struct {
+	mock *MockBooster
+}
+
+// NewMockBooster creates a new mock instance.
+func NewMockBooster(ctrl *gomock.Controller) *MockBooster {
+	mock := &MockBooster{ctrl: ctrl}
+	mock.recorder = &MockBoosterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBooster) EXPECT() *MockBoosterMockRecorder {
+	return m.recorder
+}
+
+// Boost mocks base method.
+func (m *MockBooster) Boost(ctx context.Context) (models.Boost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Boost", ctx)
+	ret0, _ := ret[0].(models.Boost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Boost indicates an expected call of Boost.
+func (mr *MockBoosterMockRecorder) Boost(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Boost", reflect.TypeOf((*MockBooster)(nil).Boost), ctx)
+}
+
+// MockActivityTracker is a mock of ActivityTracker interface.
+type MockActivityTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityTrackerMockRecorder
+}
+
+// MockActivityTrackerMockRecorder is the mock recorder for MockActivityTracker.
+type MockActivityTrackerMockRecorder struct {
+	mock *MockActivityTracker
+}
+
+// NewMockActivityTracker creates a new mock instance.
+func NewMockActivityTracker(ctrl *gomock.Controller) *MockActivityTracker {
+	mock := &MockActivityTracker{ctrl: ctrl}
+	mock.recorder = &MockActivityTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityTracker) EXPECT() *MockActivityTrackerMockRecorder {
+	return m.recorder
+}
+
+// Touch mocks base method.
+func (m *MockActivityTracker) Touch(ctx context.Context, userID uint64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Touch", ctx, userID)
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockActivityTrackerMockRecorder) Touch(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockActivityTracker)(nil).Touch), ctx, userID)
+}
+
+// MockAuthenticator is a mock of Authenticator interface.
+type MockAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthenticatorMockRecorder
+}
+
+// MockAuthenticatorMockRecorder is the mock recorder for MockAuthenticator.
+type MockAuthenticatorMockRecorder struct {
+	mock *MockAuthenticator
+}
+
+// NewMockAuthenticator creates a new mock instance.
+func NewMockAuthenticator(ctrl *gomock.Controller) *MockAuthenticator {
+	mock := &MockAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticator) EXPECT() *MockAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockAuthenticator) Login(ctx context.Context, phone, password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, phone, password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockAuthenticatorMockRecorder) Login(ctx, phone, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthenticator)(nil).Login), ctx, phone, password)
+}
+
+// Register mocks base method.
+func (m *MockAuthenticator) Register(ctx context.Context, phone, password string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, phone, password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockAuthenticatorMockRecorder) Register(ctx, phone, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuthenticator)(nil).Register), ctx, phone, password)
+}
+
+// UnpackToken mocks base method.
+func (m *MockAuthenticator) UnpackToken(ctx context.Context, token string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpackToken", ctx, token)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpackToken indicates an expected call of UnpackToken.
+func (mr *MockAuthenticatorMockRecorder) UnpackToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpackToken", reflect.TypeOf((*MockAuthenticator)(nil).UnpackToken), ctx, token)
+}
@@ -0,0 +1,94 @@
+package websocket
+
+import (
+	"context"
+	"log"
+
+	ws_api "github.com/mayye4ka/pinder/api/ws"
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *WsServer) handleFrame(ctx context.Context, c *wsConn, data []byte) {
+	// The token is checked again for every command so a ban or a forced
+	// logout also stops connections opened before it.
+	if _, err := s.auth.UnpackToken(ctx, c.token); err != nil {
+		log.Println("ws token no longer valid", err)
+		c.close(websocket.ClosePolicyViolation, errs.ReasonOf(err))
+		return
+	}
+	var frame ws_api.ClientFrame
+	if err := proto.Unmarshal(data, &frame); err != nil {
+		log.Println("ws bad frame", err)
+		return
+	}
+	messageId, err := s.executeCommand(ctx, &frame)
+	if err != nil {
+		log.Println("ws command error", err)
+	}
+	if !c.framed || frame.CommandId == "" {
+		return
+	}
+	ack := &ws_api.Ack{
+		CommandId: frame.CommandId,
+		Ok:        err == nil,
+		MessageId: messageId,
+	}
+	if err != nil {
+		ack.ErrorReason = errs.ReasonOf(err)
+		ack.ErrorMessage = err.Error()
+	}
+	bytes, err := proto.Marshal(&ws_api.ServerFrame{
+		Frame: &ws_api.ServerFrame_Ack{Ack: ack},
+	})
+	if err != nil {
+		log.Println("ws can't marshal ack", err)
+		return
+	}
+	if err := c.write(bytes); err != nil {
+		log.Println(err)
+	}
+}
+
+func (s *WsServer) executeCommand(ctx context.Context, frame *ws_api.ClientFrame) (uint64, error) {
+	switch cmd := frame.Command.(type) {
+	case *ws_api.ClientFrame_SendMessage:
+		return s.service.SendMessage(ctx, models.OutgoingMessage{
+			ChatID:          cmd.SendMessage.ChatId,
+			ContentType:     protoToContentType(cmd.SendMessage.ContentType),
+			Payload:         string(cmd.SendMessage.Payload),
+			ClientMessageID: cmd.SendMessage.ClientMessageId,
+			ReplyToID:       cmd.SendMessage.ReplyToId,
+		})
+	case *ws_api.ClientFrame_MarkRead:
+		return 0, s.service.MarkRead(ctx, cmd.MarkRead.ChatId, cmd.MarkRead.UpToMessageId)
+	case *ws_api.ClientFrame_TypingStarted:
+		return 0, s.service.SetTyping(ctx, cmd.TypingStarted.ChatId, true)
+	case *ws_api.ClientFrame_TypingStopped:
+		return 0, s.service.SetTyping(ctx, cmd.TypingStopped.ChatId, false)
+	case *ws_api.ClientFrame_EditMessage:
+		return cmd.EditMessage.MessageId, s.service.EditMessage(ctx, cmd.EditMessage.MessageId, cmd.EditMessage.Text)
+	case *ws_api.ClientFrame_DeleteMessage:
+		return cmd.DeleteMessage.MessageId, s.service.DeleteMessage(ctx, cmd.DeleteMessage.MessageId, cmd.DeleteMessage.ForEveryone)
+	case *ws_api.ClientFrame_React:
+		return cmd.React.MessageId, s.service.React(ctx, cmd.React.MessageId, cmd.React.Emoji)
+	case *ws_api.ClientFrame_Unreact:
+		return cmd.Unreact.MessageId, s.service.Unreact(ctx, cmd.Unreact.MessageId)
+	default:
+		return 0, errs.InvalidField("bad frame", "command", "unknown command")
+	}
+}
+
+func protoToContentType(ct ws_api.ContentType) models.MsgContentType {
+	switch ct {
+	case ws_api.ContentType_CONTENT_TYPE_PHOTO:
+		return models.ContentPhoto
+	case ws_api.ContentType_CONTENT_TYPE_VOICE:
+		return models.ContentVoice
+	default:
+		return models.ContentText
+	}
+}
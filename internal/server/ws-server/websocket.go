@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
-	public_api "github.com/mayye4ka/pinder-api/api/go"
-	notification_api "github.com/mayye4ka/pinder-api/notifications/go"
+	ws_api "github.com/mayye4ka/pinder/api/ws"
+	"github.com/mayye4ka/pinder/internal/models"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/gorilla/websocket"
@@ -20,14 +22,23 @@ import (
 const (
 	tokenHeader             = "Authorization"
 	authorizationTrimPrefix = "Bearer "
+	userIdContextKey        = "user_id"
+	framedSubprotocol       = "pinder.frames.v1"
+	lastSeqParam            = "last_seq"
+	replayPageSize          = 100
+	// maxFrameSize fits the service's 10MB media limit plus the frame
+	// around it.
+	maxFrameSize = 11 << 20
 )
 
 type WsServer struct {
 	auth                 Authenticator
+	service              Service
 	notificationProducer NotificationProducer
+	store                NotificationStore
 	port                 int
 
-	connStore   map[uint64]map[string]*websocket.Conn
+	connStore   map[uint64]map[string]*wsConn
 	connStoreMu sync.RWMutex
 
 	httpServer              *http.Server
@@ -39,40 +50,175 @@ type Authenticator interface {
 	UnpackToken(ctx context.Context, token string) (uint64, error)
 }
 
+type Service interface {
+	SendMessage(ctx context.Context, msg models.OutgoingMessage) (uint64, error)
+	MarkRead(ctx context.Context, chatId, upToMessageId uint64) error
+	SetTyping(ctx context.Context, chatId uint64, typing bool) error
+	EditMessage(ctx context.Context, messageId uint64, text string) error
+	DeleteMessage(ctx context.Context, messageId uint64, forEveryone bool) error
+	React(ctx context.Context, messageId uint64, emoji string) error
+	Unreact(ctx context.Context, messageId uint64) error
+}
+
+type wsConn struct {
+	conn    *websocket.Conn
+	token   string
+	framed  bool
+	writeMu sync.Mutex
+
+	replaying bool
+	pending   []models.UserNotification
+	lastSeq   uint64
+}
+
+func (c *wsConn) write(bytes []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteMessage(websocket.BinaryMessage, bytes)
+}
+
+// close tells the client why before dropping the connection; serveConn
+// then cleans it up when its read fails.
+func (c *wsConn) close(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	msg := websocket.FormatCloseMessage(code, reason)
+	_ = c.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+	c.conn.Close()
+}
+
+func (c *wsConn) push(n models.UserNotification) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.replaying {
+		c.pending = append(c.pending, n)
+		return nil
+	}
+	return c.send(n)
+}
+
+func (c *wsConn) send(n models.UserNotification) error {
+	if n.Seq != 0 && n.Seq <= c.lastSeq {
+		return nil
+	}
+	c.lastSeq = max(c.lastSeq, n.Seq)
+	if !c.framed {
+		if n.Kind == models.NotificationEvent {
+			return nil
+		}
+		return c.conn.WriteMessage(websocket.BinaryMessage, n.Payload)
+	}
+	frame := &ws_api.ServerFrame{Seq: n.Seq}
+	if n.Kind == models.NotificationEvent {
+		var event ws_api.Event
+		if err := proto.Unmarshal(n.Payload, &event); err != nil {
+			return err
+		}
+		frame.Frame = &ws_api.ServerFrame_Event{Event: &event}
+	} else {
+		frame.Frame = &ws_api.ServerFrame_Notification{Notification: n.Payload}
+	}
+	bytes, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	return c.conn.WriteMessage(websocket.BinaryMessage, bytes)
+}
+
 type NotificationProducer interface {
-	Notifications() <-chan *notification_api.UserNotification
+	Notifications() <-chan models.UserNotification
 }
 
-func NewWsServer(auth Authenticator, ntfcProducer NotificationProducer, port int) *WsServer {
+type NotificationStore interface {
+	GetNotificationsAfter(ctx context.Context, userID, afterSeq uint64, limit int) ([]models.UserNotification, error)
+}
+
+func NewWsServer(auth Authenticator, service Service, ntfcProducer NotificationProducer, store NotificationStore, port int) *WsServer {
 	return &WsServer{
 		auth:                 auth,
+		service:              service,
 		notificationProducer: ntfcProducer,
+		store:                store,
 		port:                 port,
 
-		connStore: map[uint64]map[string]*websocket.Conn{},
+		connStore: map[uint64]map[string]*wsConn{},
 
 		finishNotifications:     make(chan struct{}),
 		finishNotificationsDone: make(chan struct{}),
 	}
 }
 
-func (s *WsServer) addUser(id uint64, conn *websocket.Conn) {
+func (s *WsServer) addUser(id uint64, token string, conn *websocket.Conn, lastSeq *uint64) {
+	c := &wsConn{
+		conn:   conn,
+		token:  token,
+		framed: conn.Subprotocol() == framedSubprotocol,
+	}
+	if lastSeq != nil {
+		c.replaying = true
+		c.lastSeq = *lastSeq
+	}
 	s.connStoreMu.Lock()
 	if s.connStore[id] == nil {
-		s.connStore[id] = map[string]*websocket.Conn{}
+		s.connStore[id] = map[string]*wsConn{}
 	}
 	connId := uuid.New().String()
-	s.connStore[id][connId] = conn
+	s.connStore[id][connId] = c
 	s.connStoreMu.Unlock()
-	go s.serveConn(id, connId, conn)
+	if c.replaying {
+		go s.replay(id, c)
+	}
+	go s.serveConn(id, connId, c)
+}
+
+func (s *WsServer) replay(id uint64, c *wsConn) {
+	defer c.finishReplay()
+	ctx := context.Background()
+	for {
+		c.writeMu.Lock()
+		after := c.lastSeq
+		c.writeMu.Unlock()
+		batch, err := s.store.GetNotificationsAfter(ctx, id, after, replayPageSize)
+		if err != nil {
+			log.Println("ws can't get missed notifications", err)
+			return
+		}
+		for _, n := range batch {
+			c.writeMu.Lock()
+			err = c.send(n)
+			c.writeMu.Unlock()
+			if err != nil {
+				log.Println("ws can't replay notification", err)
+				return
+			}
+		}
+		if len(batch) < replayPageSize {
+			return
+		}
+	}
+}
+
+func (c *wsConn) finishReplay() {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	for _, n := range c.pending {
+		if err := c.send(n); err != nil {
+			log.Println(err)
+			break
+		}
+	}
+	c.pending = nil
+	c.replaying = false
 }
 
-func (s *WsServer) serveConn(id uint64, connId string, conn *websocket.Conn) {
+func (s *WsServer) serveConn(id uint64, connId string, c *wsConn) {
+	ctx := context.WithValue(context.Background(), userIdContextKey, id)
+	c.conn.SetReadLimit(maxFrameSize)
 	for {
-		_, _, err := conn.ReadMessage()
+		msgType, data, err := c.conn.ReadMessage()
 		if err != nil {
 			log.Println("ws read error", err)
-			conn.Close()
+			c.conn.Close()
 			s.connStoreMu.Lock()
 			delete(s.connStore[id], connId)
 			if len(s.connStore[id]) == 0 {
@@ -81,13 +227,16 @@ func (s *WsServer) serveConn(id uint64, connId string, conn *websocket.Conn) {
 			s.connStoreMu.Unlock()
 			break
 		}
+		if msgType == websocket.BinaryMessage {
+			s.handleFrame(ctx, c, data)
+		}
 	}
 }
 
-func (s *WsServer) sendBytes(id uint64, bytes []byte) {
+func (s *WsServer) notify(n models.UserNotification) {
 	s.connStoreMu.RLock()
-	for _, conn := range s.connStore[id] {
-		err := conn.WriteMessage(websocket.BinaryMessage, bytes)
+	for _, c := range s.connStore[n.UserID] {
+		err := c.push(n)
 		if err != nil {
 			log.Println(err)
 		}
@@ -95,17 +244,10 @@ func (s *WsServer) sendBytes(id uint64, bytes []byte) {
 	s.connStoreMu.RUnlock()
 }
 
-func (s *WsServer) notify(userId uint64, notification *public_api.DataPackage) error {
-	bytes, err := proto.Marshal(notification)
-	if err != nil {
-		return nil
-	}
-	s.sendBytes(userId, bytes)
-	return nil
+var upgrader = websocket.Upgrader{
+	Subprotocols: []string{framedSubprotocol},
 }
 
-var upgrader = websocket.Upgrader{}
-
 func (s *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Header.Get(tokenHeader) == "" {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -118,13 +260,22 @@ func (s *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		log.Println(err)
 		return
 	}
+	var lastSeq *uint64
+	if raw := r.URL.Query().Get(lastSeqParam); raw != "" {
+		seq, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		lastSeq = &seq
+	}
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		log.Println(err)
 		return
 	}
-	s.addUser(userId, conn)
+	s.addUser(userId, token, conn, lastSeq)
 }
 
 func (s *WsServer) Start(ctx context.Context) error {
@@ -160,10 +311,7 @@ func (s *WsServer) startNotificationSending(ctx context.Context) error {
 			close(s.finishNotificationsDone)
 			return nil
 		case n := <-c:
-			err := s.notify(n.UserId, n.DataPackage)
-			if err != nil {
-				return err
-			}
+			s.notify(n)
 		}
 	}
 }
@@ -199,10 +347,10 @@ func (s *WsServer) stopHttpServer(ctx context.Context) error {
 func (s *WsServer) closeConnections() {
 	s.connStoreMu.Lock()
 	for _, cm := range s.connStore {
-		for _, conn := range cm {
-			conn.Close()
+		for _, c := range cm {
+			c.conn.Close()
 		}
 	}
-	s.connStore = map[uint64]map[string]*websocket.Conn{}
+	s.connStore = map[uint64]map[string]*wsConn{}
 	s.connStoreMu.Unlock()
 }
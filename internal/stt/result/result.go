@@ -45,7 +45,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		s.logger.Err(err).Msg("can't open rabbitmq channel")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -63,7 +63,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		s.logger.Err(err).Msg("can't declare stt results exchange")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare stt results exchange",
 		}
 	}
@@ -79,7 +79,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		s.logger.Err(err).Msg("can't declare stt results queue")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare stt results queue",
 		}
 	}
@@ -94,7 +94,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		s.logger.Err(err).Msg("can't bind stt results queue")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't bind stt results queue",
 		}
 	}
@@ -111,7 +111,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 	if err != nil {
 		s.logger.Err(err).Msg("can't start rabbitmq consumer")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't start rabbitmq consumer",
 		}
 	}
@@ -130,7 +130,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 			if err != nil {
 				s.logger.Err(err).Msg("can't unmarshal stt result")
 				return &errs.CodableError{
-					Code:    errs.CodeInternal,
+					Code:    errs.CodeFromCause(err),
 					Message: "can't unmarshal stt result",
 				}
 			}
@@ -144,7 +144,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 			if err != nil {
 				s.logger.Err(err).Msg("can't handle stt result")
 				return &errs.CodableError{
-					Code:    errs.CodeInternal,
+					Code:    errs.CodeFromCause(err),
 					Message: "can't handle stt result",
 				}
 			} else {
@@ -152,7 +152,7 @@ func (s *SttResultReceiver) Start(ctx context.Context) error {
 				if err != nil {
 					s.logger.Err(err).Msg("can't ack stt result")
 					return &errs.CodableError{
-						Code:    errs.CodeInternal,
+						Code:    errs.CodeFromCause(err),
 						Message: "can't ack stt result",
 					}
 				}
@@ -26,7 +26,7 @@ func NewTaskCreator(rabbit *amqp.Connection, logger *zerolog.Logger) (*SttTaskCr
 	if err != nil {
 		logger.Err(err).Msg("can't open rabbitmq channel")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -44,7 +44,7 @@ func NewTaskCreator(rabbit *amqp.Connection, logger *zerolog.Logger) (*SttTaskCr
 	if err != nil {
 		logger.Err(err).Msg("can't declare stt tasks exchange")
 		return nil, &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't declare stt tasks exchange",
 		}
 	}
@@ -65,7 +65,7 @@ func (s *SttTaskCreator) PutTask(ctx context.Context, task models.SttTask) error
 	if err != nil {
 		s.logger.Err(err).Msg("can't marshal stt task")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeFromCause(err),
 			Message: "can't marshal stt task",
 		}
 	}
@@ -73,7 +73,7 @@ func (s *SttTaskCreator) PutTask(ctx context.Context, task models.SttTask) error
 	if err != nil {
 		s.logger.Err(err).Msg("can't open rabbitmq channel")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't open rabbitmq channel",
 		}
 	}
@@ -87,7 +87,7 @@ func (s *SttTaskCreator) PutTask(ctx context.Context, task models.SttTask) error
 	if err != nil {
 		s.logger.Err(err).Msg("can't publish stt task to rabbitmq")
 		return &errs.CodableError{
-			Code:    errs.CodeInternal,
+			Code:    errs.CodeUnavailable,
 			Message: "can't publish stt task to rabbitmq",
 		}
 	}
@@ -0,0 +1,53 @@
+package activity
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+const touchInterval = 5 * time.Minute
+
+type Tracker struct {
+	repository Repository
+	logger     *zerolog.Logger
+
+	mu        sync.Mutex
+	lastTouch map[uint64]time.Time
+}
+
+type Repository interface {
+	TouchUserActivity(ctx context.Context, userID uint64, at time.Time) error
+}
+
+func New(repo Repository, logger *zerolog.Logger) *Tracker {
+	return &Tracker{
+		repository: repo,
+		logger:     logger,
+		lastTouch:  map[uint64]time.Time{},
+	}
+}
+
+func (t *Tracker) Touch(ctx context.Context, userID uint64) {
+	if userID == 0 {
+		return
+	}
+	now := time.Now()
+	t.mu.Lock()
+	if last, ok := t.lastTouch[userID]; ok && now.Sub(last) < touchInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.lastTouch[userID] = now
+	t.mu.Unlock()
+
+	err := t.repository.TouchUserActivity(ctx, userID, now)
+	if err != nil {
+		t.logger.Err(err).Uint64("user_id", userID).Msg("can't touch user activity")
+		t.mu.Lock()
+		delete(t.lastTouch, userID)
+		t.mu.Unlock()
+	}
+}
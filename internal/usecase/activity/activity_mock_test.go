@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/activity/activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/usecase/activity/activity.go -destination internal/usecase/activity/activity_mock_test.go -package activity
+//
+
+// Package activity is a generated GoMock package.
+package activity
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// TouchUserActivity mocks base method.
+func (m *MockRepository) TouchUserActivity(ctx context.Context, userID uint64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserActivity", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchUserActivity indicates an expected call of TouchUserActivity.
+func (mr *MockRepositoryMockRecorder) TouchUserActivity(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserActivity", reflect.TypeOf((*MockRepository)(nil).TouchUserActivity), ctx, userID, at)
+}
@@ -0,0 +1,53 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	userId = uint64(123)
+	ctx    = context.Background()
+)
+
+type ActivityTestSuite struct {
+	suite.Suite
+	repoMock *MockRepository
+	tracker  *Tracker
+}
+
+func TestActivity(t *testing.T) {
+	suite.Run(t, new(ActivityTestSuite))
+}
+
+func (s *ActivityTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repoMock = NewMockRepository(ctrl)
+	logger := zerolog.New(io.Discard)
+	s.tracker = New(s.repoMock, &logger)
+}
+
+func (s *ActivityTestSuite) TestTouch_Throttled() {
+	s.repoMock.EXPECT().TouchUserActivity(ctx, userId, gomock.Any()).Return(nil).Times(1)
+
+	s.tracker.Touch(ctx, userId)
+	s.tracker.Touch(ctx, userId)
+}
+
+func (s *ActivityTestSuite) TestTouch_RetriesAfterError() {
+	s.repoMock.EXPECT().TouchUserActivity(ctx, userId, gomock.Any()).Return(errors.New("db is down"))
+	s.repoMock.EXPECT().TouchUserActivity(ctx, userId, gomock.Any()).Return(nil)
+
+	s.tracker.Touch(ctx, userId)
+	s.tracker.Touch(ctx, userId)
+}
+
+func (s *ActivityTestSuite) TestTouch_Anonymous() {
+	s.tracker.Touch(ctx, 0)
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const adminIdContextKey = "admin_id"
+
+var errUnauthenticated = &errs.CodableError{
+	Code:    errs.CodeUnauthenticated,
+	Message: "unauthenticated for this endpoint",
+	Reason:  errs.ReasonUnauthenticated,
+}
+
+type Admin struct {
+	repository  Repository
+	filestorage FileStorage
+	auditor     Auditor
+}
+
+type Repository interface {
+	GetUser(ctx context.Context, userID uint64) (models.User, error)
+	GetUserByPhone(ctx context.Context, phoneNumber string) (models.User, error)
+	SetUserBanned(ctx context.Context, userID uint64, banned bool) error
+	RevokeUserTokens(ctx context.Context, userID uint64) error
+
+	GetProfile(ctx context.Context, userID uint64) (models.Profile, error)
+	GetPreferences(ctx context.Context, userID uint64) (models.Preferences, error)
+	GetUserPhotos(ctx context.Context, userID uint64) ([]string, error)
+	DeleteUserPhoto(ctx context.Context, userID uint64, photoKey string) error
+
+	GetUserPairAttempts(ctx context.Context, userID uint64) ([]models.PairAttempt, error)
+	GetPairEvents(ctx context.Context, PAID uint64) ([]models.PairEvent, error)
+
+	GetChats(ctx context.Context, userID uint64) ([]models.Chat, error)
+	GetChatActivity(ctx context.Context, chatID uint64) (models.ChatActivity, error)
+}
+
+type FileStorage interface {
+	DelProfilePhoto(ctx context.Context, photoKey string) error
+	MakeProfilePhotoLink(ctx context.Context, photoKey string) (string, error)
+}
+
+type Auditor interface {
+	Record(ctx context.Context, record models.AuditRecord) error
+	List(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error)
+	Export(ctx context.Context, filter models.AuditFilter) (models.AuditExport, error)
+}
+
+func New(repo Repository, filestorage FileStorage, auditor Auditor) *Admin {
+	return &Admin{
+		repository:  repo,
+		filestorage: filestorage,
+		auditor:     auditor,
+	}
+}
+
+func (a *Admin) audit(ctx context.Context, adminId uint64, action models.AuditAction, targetId uint64, details string) error {
+	err := a.auditor.Record(ctx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   action,
+		TargetID: targetId,
+		Details:  details,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't write audit record")
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/admin/admin.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/usecase/admin/admin.go -destination internal/usecase/admin/admin_mock_test.go -package admin
+//
+
+// Package admin is a generated GoMock package.
+package admin
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/mayye4ka/pinder/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserPhoto mocks base method.
+func (m *MockRepository) DeleteUserPhoto(ctx context.Context, userID uint64, photoKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPhoto", ctx, userID, photoKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserPhoto indicates an expected call of DeleteUserPhoto.
+func (mr *MockRepositoryMockRecorder) DeleteUserPhoto(ctx, userID, photoKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPhoto", reflect.TypeOf((*MockRepository)(nil).DeleteUserPhoto), ctx, userID, photoKey)
+}
+
+// GetChatActivity mocks base method.
+func (m *MockRepository) GetChatActivity(ctx context.Context, chatID uint64) (models.ChatActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatActivity", ctx, chatID)
+	ret0, _ := ret[0].(models.ChatActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatActivity indicates an expected call of GetChatActivity.
+func (mr *MockRepositoryMockRecorder) GetChatActivity(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatActivity", reflect.TypeOf((*MockRepository)(nil).GetChatActivity), ctx, chatID)
+}
+
+// GetChats mocks base method.
+func (m *MockRepository) GetChats(ctx context.Context, userID uint64) ([]models.Chat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChats", ctx, userID)
+	ret0, _ := ret[0].([]models.Chat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChats indicates an expected call of GetChats.
+func (mr *MockRepositoryMockRecorder) GetChats(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockRepository)(nil).GetChats), ctx, userID)
+}
+
+// GetPairEvents mocks base method.
+func (m *MockRepository) GetPairEvents(ctx context.Context, PAID uint64) ([]models.PairEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPairEvents", ctx, PAID)
+	ret0, _ := ret[0].([]models.PairEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPairEvents indicates an expected call of GetPairEvents.
+func (mr *MockRepositoryMockRecorder) GetPairEvents(ctx, PAID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPairEvents", reflect.TypeOf((*MockRepository)(nil).GetPairEvents), ctx, PAID)
+}
+
+// GetPreferences mocks base method.
+func (m *MockRepository) GetPreferences(ctx context.Context, userID uint64) (models.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, userID)
+	ret0, _ := ret[0].(models.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockRepositoryMockRecorder) GetPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockRepository)(nil).GetPreferences), ctx, userID)
+}
+
+// GetProfile mocks base method.
+func (m *MockRepository) GetProfile(ctx context.Context, userID uint64) (models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, userID)
+	ret0, _ := ret[0].(models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockRepositoryMockRecorder) GetProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockRepository)(nil).GetProfile), ctx, userID)
+}
+
+// GetUser mocks base method.
+func (m *MockRepository) GetUser(ctx context.Context, userID uint64) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, userID)
+}
+
+// GetUserByPhone mocks base method.
+func (m *MockRepository) GetUserByPhone(ctx context.Context, phoneNumber string) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByPhone", ctx, phoneNumber)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByPhone indicates an expected call of GetUserByPhone.
+func (mr *MockRepositoryMockRecorder) GetUserByPhone(ctx, phoneNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByPhone", reflect.TypeOf((*MockRepository)(nil).GetUserByPhone), ctx, phoneNumber)
+}
+
+// GetUserPairAttempts mocks base method.
+func (m *MockRepository) GetUserPairAttempts(ctx context.Context, userID uint64) ([]models.PairAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPairAttempts", ctx, userID)
+	ret0, _ := ret[0].([]models.PairAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPairAttempts indicates an expected call of GetUserPairAttempts.
+func (mr *MockRepositoryMockRecorder) GetUserPairAttempts(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPairAttempts", reflect.TypeOf((*MockRepository)(nil).GetUserPairAttempts), ctx, userID)
+}
+
+// GetUserPhotos mocks base method.
+func (m *MockRepository) GetUserPhotos(ctx context.Context, userID uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPhotos", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPhotos indicates an expected call of GetUserPhotos.
+func (mr *MockRepositoryMockRecorder) GetUserPhotos(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhotos", reflect.TypeOf((*MockRepository)(nil).GetUserPhotos), ctx, userID)
+}
+
+// RevokeUserTokens mocks base method.
+func (m *MockRepository) RevokeUserTokens(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockRepositoryMockRecorder) RevokeUserTokens(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockRepository)(nil).RevokeUserTokens), ctx, userID)
+}
+
+// SetUserBanned mocks base method.
+func (m *MockRepository) SetUserBanned(ctx context.Context, userID uint64, banned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserBanned", ctx, userID, banned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserBanned indicates an expected call of SetUserBanned.
+func (mr *MockRepositoryMockRecorder) SetUserBanned(ctx, userID, banned any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserBanned", reflect.TypeOf((*MockRepository)(nil).SetUserBanned), ctx, userID, banned)
+}
+
+// MockFileStorage is a mock of FileStorage interface.
+type MockFileStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileStorageMockRecorder
+}
+
+// MockFileStorageMockRecorder is the mock recorder for MockFileStorage.
+type MockFileStorageMockRecorder struct {
+	mock *MockFileStorage
+}
+
+// NewMockFileStorage creates a new mock instance.
+func NewMockFileStorage(ctrl *gomock.Controller) *MockFileStorage {
+	mock := &MockFileStorage{ctrl: ctrl}
+	mock.recorder = &MockFileStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileStorage) EXPECT() *MockFileStorageMockRecorder {
+	return m.recorder
+}
+
+// DelProfilePhoto mocks base method.
+func (m *MockFileStorage) DelProfilePhoto(ctx context.Context, photoKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelProfilePhoto", ctx, photoKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelProfilePhoto indicates an expected call of DelProfilePhoto.
+func (mr *MockFileStorageMockRecorder) DelProfilePhoto(ctx, photoKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelProfilePhoto", reflect.TypeOf((*MockFileStorage)(nil).DelProfilePhoto), ctx, photoKey)
+}
+
+// MakeProfilePhotoLink mocks base method.
+func (m *MockFileStorage) MakeProfilePhotoLink(ctx context.Context, photoKey string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MakeProfilePhotoLink", ctx, photoKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MakeProfilePhotoLink indicates an expected call of MakeProfilePhotoLink.
+func (mr *MockFileStorageMockRecorder) MakeProfilePhotoLink(ctx, photoKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeProfilePhotoLink", reflect.TypeOf((*MockFileStorage)(nil).MakeProfilePhotoLink), ctx, photoKey)
+}
+
+// MockAuditor is a mock of Auditor interface.
+type MockAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditorMockRecorder
+}
+
+// MockAuditorMockRecorder is the mock recorder for MockAuditor.
+type MockAuditorMockRecorder struct {
+	mock *MockAuditor
+}
+
+// NewMockAuditor creates a new mock instance.
+func NewMockAuditor(ctrl *gomock.Controller) *MockAuditor {
+	mock := &MockAuditor{ctrl: ctrl}
+	mock.recorder = &MockAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditor) EXPECT() *MockAuditorMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockAuditor) Export(ctx context.Context, filter models.AuditFilter) (models.AuditExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, filter)
+	ret0, _ := ret[0].(models.AuditExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockAuditorMockRecorder) Export(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockAuditor)(nil).Export), ctx, filter)
+}
+
+// List mocks base method.
+func (m *MockAuditor) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, filter)
+	ret0, _ := ret[0].([]models.AuditRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAuditorMockRecorder) List(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditor)(nil).List), ctx, filter)
+}
+
+// Record mocks base method.
+func (m *MockAuditor) Record(ctx context.Context, record models.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditorMockRecorder) Record(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditor)(nil).Record), ctx, record)
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminId  = uint64(1)
+	adminCtx = context.WithValue(context.Background(), adminIdContextKey, adminId)
+	userId   = uint64(123)
+
+	phoneNumber = "12345678"
+	photo1      = "ph1"
+	photo1Link  = "link1"
+	photo2      = "ph2"
+	reason      = "spam"
+)
+
+type AdminTestSuite struct {
+	suite.Suite
+	repoMock    *MockRepository
+	fsMock      *MockFileStorage
+	auditorMock *MockAuditor
+	admin       *Admin
+}
+
+func TestAdmin(t *testing.T) {
+	suite.Run(t, new(AdminTestSuite))
+}
+
+func (s *AdminTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repoMock = NewMockRepository(ctrl)
+	s.fsMock = NewMockFileStorage(ctrl)
+	s.auditorMock = NewMockAuditor(ctrl)
+	s.admin = New(s.repoMock, s.fsMock, s.auditorMock)
+}
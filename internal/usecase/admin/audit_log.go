@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+func (a *Admin) ListAuditRecords(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return nil, errUnauthenticated
+	}
+	records, err := a.auditor.List(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't list audit records")
+	}
+	err = a.audit(ctx, adminId, models.AuditActionViewAuditLog, filter.TargetID, "")
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (a *Admin) ExportAuditRecords(ctx context.Context, filter models.AuditFilter) (models.AuditExport, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return models.AuditExport{}, errUnauthenticated
+	}
+	export, err := a.auditor.Export(ctx, filter)
+	if err != nil {
+		return models.AuditExport{}, errors.Wrap(err, "can't export audit records")
+	}
+	if filter.AfterID == 0 {
+		err = a.audit(ctx, adminId, models.AuditActionExportAuditLog, filter.TargetID, "")
+		if err != nil {
+			return models.AuditExport{}, err
+		}
+	}
+	return export, nil
+}
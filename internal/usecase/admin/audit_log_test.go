@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *AdminTestSuite) TestListAuditRecords() {
+	filter := models.AuditFilter{TargetID: userId, Limit: 10}
+	records := []models.AuditRecord{{ID: 1, ActorID: userId, Action: models.AuditActionLogin}}
+	s.auditorMock.EXPECT().List(adminCtx, filter).Return(records, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionViewAuditLog,
+		TargetID: userId,
+	}).Return(nil)
+
+	got, err := s.admin.ListAuditRecords(adminCtx, filter)
+
+	s.Nil(err)
+	s.Equal(records, got)
+}
+
+func (s *AdminTestSuite) TestExportAuditRecords() {
+	filter := models.AuditFilter{ActorID: userId}
+	export := models.AuditExport{Data: []byte("{\"id\":1}\n"), NextAfterID: 1}
+	s.auditorMock.EXPECT().Export(adminCtx, filter).Return(export, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID: adminId,
+		Action:  models.AuditActionExportAuditLog,
+	}).Return(nil)
+
+	got, err := s.admin.ExportAuditRecords(adminCtx, filter)
+
+	s.Nil(err)
+	s.Equal(export, got)
+}
+
+func (s *AdminTestSuite) TestExportAuditRecords_NextPageNotAudited() {
+	filter := models.AuditFilter{ActorID: userId, AfterID: 1}
+	export := models.AuditExport{Data: []byte("{\"id\":2}\n")}
+	s.auditorMock.EXPECT().Export(adminCtx, filter).Return(export, nil)
+
+	got, err := s.admin.ExportAuditRecords(adminCtx, filter)
+
+	s.Nil(err)
+	s.Equal(export, got)
+}
@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+func (a *Admin) FindUserByID(ctx context.Context, userId uint64) (models.User, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return models.User{}, errUnauthenticated
+	}
+	user, err := a.repository.GetUser(ctx, userId)
+	if err != nil {
+		return models.User{}, errors.Wrap(err, "can't get user")
+	}
+	err = a.audit(ctx, adminId, models.AuditActionLookupUser, user.ID, "by id")
+	if err != nil {
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+func (a *Admin) FindUserByPhone(ctx context.Context, phoneNumber string) (models.User, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return models.User{}, errUnauthenticated
+	}
+	user, err := a.repository.GetUserByPhone(ctx, phoneNumber)
+	if err != nil {
+		return models.User{}, errors.Wrap(err, "can't get user by phone")
+	}
+	err = a.audit(ctx, adminId, models.AuditActionLookupUser, user.ID, "by phone")
+	if err != nil {
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+func (a *Admin) GetUserProfile(ctx context.Context, userId uint64) (models.ProfileShowcase, models.Preferences, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return models.ProfileShowcase{}, models.Preferences{}, errUnauthenticated
+	}
+	profile, err := a.repository.GetProfile(ctx, userId)
+	if err != nil {
+		return models.ProfileShowcase{}, models.Preferences{}, errors.Wrap(err, "can't get profile")
+	}
+	preferences, err := a.repository.GetPreferences(ctx, userId)
+	if err != nil {
+		return models.ProfileShowcase{}, models.Preferences{}, errors.Wrap(err, "can't get preferences")
+	}
+	photoKeys, err := a.repository.GetUserPhotos(ctx, userId)
+	if err != nil {
+		return models.ProfileShowcase{}, models.Preferences{}, errors.Wrap(err, "can't get user photos")
+	}
+	photos := make([]models.PhotoShowcase, len(photoKeys))
+	for i, key := range photoKeys {
+		link, err := a.filestorage.MakeProfilePhotoLink(ctx, key)
+		if err != nil {
+			return models.ProfileShowcase{}, models.Preferences{}, errors.Wrap(err, "can't make profile photo link")
+		}
+		photos[i] = models.PhotoShowcase{
+			Key:  key,
+			Link: link,
+		}
+	}
+	err = a.audit(ctx, adminId, models.AuditActionViewProfile, userId, "")
+	if err != nil {
+		return models.ProfileShowcase{}, models.Preferences{}, err
+	}
+	return models.ProfileShowcase{
+		Profile: profile,
+		Photos:  photos,
+	}, preferences, nil
+}
+
+func (a *Admin) ListPairAttempts(ctx context.Context, userId uint64) ([]models.PairAttemptHistory, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return nil, errUnauthenticated
+	}
+	pas, err := a.repository.GetUserPairAttempts(ctx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get user pair attempts")
+	}
+	res := make([]models.PairAttemptHistory, len(pas))
+	for i, pa := range pas {
+		events, err := a.repository.GetPairEvents(ctx, pa.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get pair events")
+		}
+		res[i] = models.PairAttemptHistory{
+			PairAttempt: pa,
+			Events:      events,
+		}
+	}
+	err = a.audit(ctx, adminId, models.AuditActionViewPairs, userId, "")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *Admin) ListChats(ctx context.Context, userId uint64) ([]models.ChatInfo, error) {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return nil, errUnauthenticated
+	}
+	chats, err := a.repository.GetChats(ctx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get chats")
+	}
+	res := make([]models.ChatInfo, len(chats))
+	for i, chat := range chats {
+		activity, err := a.repository.GetChatActivity(ctx, chat.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "can't get chat activity")
+		}
+		res[i] = models.ChatInfo{
+			Chat:     chat,
+			Activity: activity,
+		}
+	}
+	err = a.audit(ctx, adminId, models.AuditActionViewChats, userId, "")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
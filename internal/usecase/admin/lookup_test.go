@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *AdminTestSuite) TestFindUserByPhone() {
+	user := models.User{ID: userId, PhoneNumber: phoneNumber}
+	s.repoMock.EXPECT().GetUserByPhone(adminCtx, phoneNumber).Return(user, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionLookupUser,
+		TargetID: userId,
+		Details:  "by phone",
+	}).Return(nil)
+
+	got, err := s.admin.FindUserByPhone(adminCtx, phoneNumber)
+
+	s.Nil(err)
+	s.Equal(user, got)
+}
+
+func (s *AdminTestSuite) TestFindUserByID_Unauthenticated() {
+	ctx := context.WithValue(context.Background(), adminIdContextKey, uint64(0))
+
+	_, err := s.admin.FindUserByID(ctx, userId)
+
+	s.Equal(errUnauthenticated, err)
+}
+
+func (s *AdminTestSuite) TestGetUserProfile() {
+	s.repoMock.EXPECT().GetProfile(adminCtx, userId).Return(models.Profile{UserID: userId}, nil)
+	s.repoMock.EXPECT().GetPreferences(adminCtx, userId).Return(models.Preferences{UserID: userId}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(adminCtx, userId).Return([]string{photo1}, nil)
+	s.fsMock.EXPECT().MakeProfilePhotoLink(adminCtx, photo1).Return(photo1Link, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionViewProfile,
+		TargetID: userId,
+	}).Return(nil)
+
+	profile, preferences, err := s.admin.GetUserProfile(adminCtx, userId)
+
+	s.Nil(err)
+	s.Equal(models.ProfileShowcase{
+		Profile: models.Profile{UserID: userId},
+		Photos:  []models.PhotoShowcase{{Key: photo1, Link: photo1Link}},
+	}, profile)
+	s.Equal(models.Preferences{UserID: userId}, preferences)
+}
+
+func (s *AdminTestSuite) TestListPairAttempts() {
+	pa := models.PairAttempt{ID: 7, User1: userId, User2: 124, State: models.PAStatePending}
+	events := []models.PairEvent{{ID: 1, PAID: 7, EventType: models.PETypePACreated}}
+	s.repoMock.EXPECT().GetUserPairAttempts(adminCtx, userId).Return([]models.PairAttempt{pa}, nil)
+	s.repoMock.EXPECT().GetPairEvents(adminCtx, pa.ID).Return(events, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionViewPairs,
+		TargetID: userId,
+	}).Return(nil)
+
+	history, err := s.admin.ListPairAttempts(adminCtx, userId)
+
+	s.Nil(err)
+	s.Equal([]models.PairAttemptHistory{{PairAttempt: pa, Events: events}}, history)
+}
+
+func (s *AdminTestSuite) TestListChats() {
+	chat := models.Chat{ID: 5, User1: userId, User2: 124}
+	activity := models.ChatActivity{MessageCount: 3, LastMessageAt: time.Unix(1000, 0)}
+	s.repoMock.EXPECT().GetChats(adminCtx, userId).Return([]models.Chat{chat}, nil)
+	s.repoMock.EXPECT().GetChatActivity(adminCtx, chat.ID).Return(activity, nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionViewChats,
+		TargetID: userId,
+	}).Return(nil)
+
+	chats, err := s.admin.ListChats(adminCtx, userId)
+
+	s.Nil(err)
+	s.Equal([]models.ChatInfo{{Chat: chat, Activity: activity}}, chats)
+}
@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+func (a *Admin) BanUser(ctx context.Context, userId uint64, reason string) error {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return errUnauthenticated
+	}
+	if adminId == userId {
+		return &errs.CodableError{
+			Code:    errs.CodeInvalidInput,
+			Message: "can't ban yourself",
+			Reason:  errs.ReasonSelfModeration,
+		}
+	}
+	err := a.repository.SetUserBanned(ctx, userId, true)
+	if err != nil {
+		return errors.Wrap(err, "can't ban user")
+	}
+	return a.audit(ctx, adminId, models.AuditActionBanUser, userId, reason)
+}
+
+func (a *Admin) UnbanUser(ctx context.Context, userId uint64, reason string) error {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return errUnauthenticated
+	}
+	err := a.repository.SetUserBanned(ctx, userId, false)
+	if err != nil {
+		return errors.Wrap(err, "can't unban user")
+	}
+	return a.audit(ctx, adminId, models.AuditActionUnbanUser, userId, reason)
+}
+
+func (a *Admin) ForceLogout(ctx context.Context, userId uint64, reason string) error {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return errUnauthenticated
+	}
+	err := a.repository.RevokeUserTokens(ctx, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't revoke user tokens")
+	}
+	return a.audit(ctx, adminId, models.AuditActionForceLogout, userId, reason)
+}
+
+func (a *Admin) TakeDownPhoto(ctx context.Context, userId uint64, photoKey string, reason string) error {
+	adminId := ctx.Value(adminIdContextKey).(uint64)
+	if adminId == 0 {
+		return errUnauthenticated
+	}
+	photos, err := a.repository.GetUserPhotos(ctx, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't get user photos")
+	}
+	found := false
+	for _, p := range photos {
+		if p == photoKey {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &errs.CodableError{
+			Code:    errs.CodeNotFound,
+			Message: "photo not found",
+			Reason:  errs.ReasonPhotoNotFound,
+		}
+	}
+	err = a.filestorage.DelProfilePhoto(ctx, photoKey)
+	if err != nil {
+		return errors.Wrap(err, "can't delete profile photo")
+	}
+	err = a.repository.DeleteUserPhoto(ctx, userId, photoKey)
+	if err != nil {
+		return errors.Wrap(err, "can't delete user photo")
+	}
+	return a.audit(ctx, adminId, models.AuditActionTakeDownPhoto, userId, fmt.Sprintf("photo: %s, reason: %s", photoKey, reason))
+}
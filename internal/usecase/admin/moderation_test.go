@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *AdminTestSuite) TestBanUser() {
+	s.repoMock.EXPECT().SetUserBanned(adminCtx, userId, true).Return(nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionBanUser,
+		TargetID: userId,
+		Details:  reason,
+	}).Return(nil)
+
+	err := s.admin.BanUser(adminCtx, userId, reason)
+
+	s.Nil(err)
+}
+
+func (s *AdminTestSuite) TestBanUser_Self() {
+	err := s.admin.BanUser(adminCtx, adminId, reason)
+
+	s.Equal("can't ban yourself", err.Error())
+}
+
+func (s *AdminTestSuite) TestUnbanUser() {
+	s.repoMock.EXPECT().SetUserBanned(adminCtx, userId, false).Return(nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionUnbanUser,
+		TargetID: userId,
+		Details:  reason,
+	}).Return(nil)
+
+	err := s.admin.UnbanUser(adminCtx, userId, reason)
+
+	s.Nil(err)
+}
+
+func (s *AdminTestSuite) TestForceLogout() {
+	s.repoMock.EXPECT().RevokeUserTokens(adminCtx, userId).Return(nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionForceLogout,
+		TargetID: userId,
+		Details:  reason,
+	}).Return(nil)
+
+	err := s.admin.ForceLogout(adminCtx, userId, reason)
+
+	s.Nil(err)
+}
+
+func (s *AdminTestSuite) TestTakeDownPhoto() {
+	s.repoMock.EXPECT().GetUserPhotos(adminCtx, userId).Return([]string{photo1, photo2}, nil)
+	s.fsMock.EXPECT().DelProfilePhoto(adminCtx, photo2).Return(nil)
+	s.repoMock.EXPECT().DeleteUserPhoto(adminCtx, userId, photo2).Return(nil)
+	s.auditorMock.EXPECT().Record(adminCtx, models.AuditRecord{
+		ActorID:  adminId,
+		Action:   models.AuditActionTakeDownPhoto,
+		TargetID: userId,
+		Details:  "photo: ph2, reason: spam",
+	}).Return(nil)
+
+	err := s.admin.TakeDownPhoto(adminCtx, userId, photo2, reason)
+
+	s.Nil(err)
+}
+
+func (s *AdminTestSuite) TestTakeDownPhoto_NotFound() {
+	s.repoMock.EXPECT().GetUserPhotos(adminCtx, userId).Return([]string{photo1}, nil)
+
+	err := s.admin.TakeDownPhoto(adminCtx, userId, photo2, reason)
+
+	s.Equal("photo not found", err.Error())
+}
@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	requestMetaContextKey = "request_meta"
+
+	defaultListLimit = 100
+	maxListLimit     = 1000
+	exportBatchSize  = 1000
+)
+
+type Auditor struct {
+	repository Repository
+	logger     *zerolog.Logger
+}
+
+type Repository interface {
+	CreateAuditRecord(ctx context.Context, record models.AuditRecord) error
+	GetAuditRecords(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error)
+}
+
+type jsonRecord struct {
+	ID        uint64    `json:"id"`
+	ActorID   uint64    `json:"actor_id"`
+	Action    string    `json:"action"`
+	TargetID  uint64    `json:"target_id"`
+	Details   string    `json:"details"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func New(repo Repository, logger *zerolog.Logger) *Auditor {
+	return &Auditor{
+		repository: repo,
+		logger:     logger,
+	}
+}
+
+func (a *Auditor) Record(ctx context.Context, record models.AuditRecord) error {
+	meta, ok := ctx.Value(requestMetaContextKey).(models.RequestMeta)
+	if ok {
+		record.Meta = meta
+	}
+	err := a.repository.CreateAuditRecord(ctx, record)
+	if err != nil {
+		return errors.Wrap(err, "can't create audit record")
+	}
+	return nil
+}
+
+func (a *Auditor) RecordOrLog(ctx context.Context, record models.AuditRecord) {
+	err := a.Record(ctx, record)
+	if err != nil {
+		a.logger.Err(err).
+			Uint64("actor_id", record.ActorID).
+			Str("action", string(record.Action)).
+			Uint64("target_id", record.TargetID).
+			Msg("can't record audit entry")
+	}
+}
+
+func (a *Auditor) List(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
+	}
+	if filter.Limit > maxListLimit {
+		filter.Limit = maxListLimit
+	}
+	records, err := a.repository.GetAuditRecords(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get audit records")
+	}
+	return records, nil
+}
+
+func (a *Auditor) Export(ctx context.Context, filter models.AuditFilter) (models.AuditExport, error) {
+	filter.Limit = exportBatchSize + 1
+	records, err := a.repository.GetAuditRecords(ctx, filter)
+	if err != nil {
+		return models.AuditExport{}, errors.Wrap(err, "can't get audit records")
+	}
+	var res models.AuditExport
+	if len(records) > exportBatchSize {
+		records = records[:exportBatchSize]
+		res.NextAfterID = records[len(records)-1].ID
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range records {
+		err = enc.Encode(toJsonRecord(rec))
+		if err != nil {
+			return models.AuditExport{}, &errs.CodableError{
+				Code:    errs.CodeInternal,
+				Message: "can't encode audit record",
+			}
+		}
+	}
+	res.Data = buf.Bytes()
+	return res, nil
+}
+
+func toJsonRecord(rec models.AuditRecord) jsonRecord {
+	return jsonRecord{
+		ID:        rec.ID,
+		ActorID:   rec.ActorID,
+		Action:    string(rec.Action),
+		TargetID:  rec.TargetID,
+		Details:   rec.Details,
+		IP:        rec.Meta.IP,
+		UserAgent: rec.Meta.UserAgent,
+		CreatedAt: rec.CreatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/audit/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/usecase/audit/audit.go -destination internal/usecase/audit/audit_mock_test.go -package audit
+//
+
+// Package audit is a generated GoMock package.
+package audit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/mayye4ka/pinder/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditRecord mocks base method.
+func (m *MockRepository) CreateAuditRecord(ctx context.Context, record models.AuditRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditRecord", ctx, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditRecord indicates an expected call of CreateAuditRecord.
+func (mr *MockRepositoryMockRecorder) CreateAuditRecord(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditRecord", reflect.TypeOf((*MockRepository)(nil).CreateAuditRecord), ctx, record)
+}
+
+// GetAuditRecords mocks base method.
+func (m *MockRepository) GetAuditRecords(ctx context.Context, filter models.AuditFilter) ([]models.AuditRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditRecords", ctx, filter)
+	ret0, _ := ret[0].([]models.AuditRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditRecords indicates an expected call of GetAuditRecords.
+func (mr *MockRepositoryMockRecorder) GetAuditRecords(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditRecords", reflect.TypeOf((*MockRepository)(nil).GetAuditRecords), ctx, filter)
+}
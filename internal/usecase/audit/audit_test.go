@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	userId = uint64(123)
+	meta   = models.RequestMeta{
+		IP:        "10.0.0.1:5555",
+		UserAgent: "grpc-go/1.67.1",
+	}
+	metaCtx = context.WithValue(context.Background(), requestMetaContextKey, meta)
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+	repoMock *MockRepository
+	auditor  *Auditor
+}
+
+func TestAudit(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
+
+func (s *AuditTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repoMock = NewMockRepository(ctrl)
+	logger := zerolog.Nop()
+	s.auditor = New(s.repoMock, &logger)
+}
+
+func (s *AuditTestSuite) TestRecord_AttachesRequestMeta() {
+	s.repoMock.EXPECT().CreateAuditRecord(metaCtx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionLogin,
+		TargetID: userId,
+		Meta:     meta,
+	}).Return(nil)
+
+	err := s.auditor.Record(metaCtx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionLogin,
+		TargetID: userId,
+	})
+
+	s.Nil(err)
+}
+
+func (s *AuditTestSuite) TestList_ClampsLimit() {
+	ctx := context.Background()
+	s.repoMock.EXPECT().GetAuditRecords(ctx, models.AuditFilter{Limit: maxListLimit}).Return(nil, nil)
+
+	_, err := s.auditor.List(ctx, models.AuditFilter{Limit: 100000})
+
+	s.Nil(err)
+}
+
+func (s *AuditTestSuite) TestExport_WritesJsonLines() {
+	ctx := context.Background()
+	createdAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	s.repoMock.EXPECT().GetAuditRecords(ctx, models.AuditFilter{
+		ActorID: userId,
+		Limit:   exportBatchSize + 1,
+	}).Return([]models.AuditRecord{
+		{ID: 1, ActorID: userId, Action: models.AuditActionRegister, TargetID: userId, Meta: meta, CreatedAt: createdAt},
+		{ID: 2, ActorID: userId, Action: models.AuditActionLogin, TargetID: userId, Meta: meta, CreatedAt: createdAt},
+	}, nil)
+
+	export, err := s.auditor.Export(ctx, models.AuditFilter{ActorID: userId})
+
+	s.Nil(err)
+	s.Zero(export.NextAfterID)
+	s.Equal(`{"id":1,"actor_id":123,"action":"register","target_id":123,"details":"","ip":"10.0.0.1:5555","user_agent":"grpc-go/1.67.1","created_at":"2024-10-01T12:00:00Z"}
+{"id":2,"actor_id":123,"action":"login","target_id":123,"details":"","ip":"10.0.0.1:5555","user_agent":"grpc-go/1.67.1","created_at":"2024-10-01T12:00:00Z"}
+`, string(export.Data))
+}
+
+func (s *AuditTestSuite) TestExport_Pages() {
+	ctx := context.Background()
+	records := make([]models.AuditRecord, exportBatchSize+1)
+	for i := range records {
+		records[i] = models.AuditRecord{ID: uint64(i + 11), ActorID: userId, Action: models.AuditActionLogin}
+	}
+	s.repoMock.EXPECT().GetAuditRecords(ctx, models.AuditFilter{
+		ActorID: userId,
+		AfterID: 10,
+		Limit:   exportBatchSize + 1,
+	}).Return(records, nil)
+
+	export, err := s.auditor.Export(ctx, models.AuditFilter{ActorID: userId, AfterID: 10})
+
+	s.Nil(err)
+	s.Equal(uint64(exportBatchSize+10), export.NextAfterID)
+	s.Equal(exportBatchSize, bytes.Count(export.Data, []byte("\n")))
+}
+
+func (s *AuditTestSuite) TestRecordOrLog_SwallowsError() {
+	record := models.AuditRecord{ActorID: userId, Action: models.AuditActionUpdateProfile, TargetID: userId}
+	s.repoMock.EXPECT().CreateAuditRecord(metaCtx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+		Meta:     meta,
+	}).Return(&errs.CodableError{Code: errs.CodeUnavailable, Message: "db down"})
+
+	s.NotPanics(func() {
+		s.auditor.RecordOrLog(metaCtx, record)
+	})
+}
@@ -16,19 +16,39 @@ import (
 var jwtSecretKey = []byte("kefjhjdfh")
 
 type Authenticator struct {
-	repo   Repository
-	logger *zerolog.Logger
+	repo    Repository
+	auditor Auditor
+	logger  *zerolog.Logger
 }
 
+var (
+	errInvalidToken = &errs.CodableError{
+		Code:    errs.CodeUnauthenticated,
+		Message: "invalid token",
+		Reason:  errs.ReasonInvalidToken,
+	}
+	errUserBanned = &errs.CodableError{
+		Code:    errs.CodePermissionDenied,
+		Message: "user is banned",
+		Reason:  errs.ReasonUserBanned,
+	}
+)
+
 type Repository interface {
 	CreateUser(ctx context.Context, phoneNumber, passHash string) (models.User, error)
 	GetUserByCreds(ctx context.Context, phoneNumber, passHash string) (models.User, error)
+	GetUser(ctx context.Context, userID uint64) (models.User, error)
+}
+
+type Auditor interface {
+	RecordOrLog(ctx context.Context, record models.AuditRecord)
 }
 
-func New(repo Repository, logger *zerolog.Logger) *Authenticator {
+func New(repo Repository, auditor Auditor, logger *zerolog.Logger) *Authenticator {
 	return &Authenticator{
-		repo:   repo,
-		logger: logger,
+		repo:    repo,
+		auditor: auditor,
+		logger:  logger,
 	}
 }
 
@@ -38,7 +58,12 @@ func (a *Authenticator) Register(ctx context.Context, phone, password string) (s
 	if err != nil {
 		return "", errors.Wrap(err, "can't register new user")
 	}
-	token, err := a.createToken(user.ID)
+	a.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  user.ID,
+		Action:   models.AuditActionRegister,
+		TargetID: user.ID,
+	})
+	token, err := a.createToken(user)
 	if err != nil {
 		return "", errors.Wrap(err, "can't generate token for registered user")
 	}
@@ -49,9 +74,27 @@ func (a *Authenticator) Login(ctx context.Context, phone, password string) (stri
 	passHash := getPassHash(password)
 	user, err := a.repo.GetUserByCreds(ctx, phone, passHash)
 	if err != nil {
+		a.auditor.RecordOrLog(ctx, models.AuditRecord{
+			Action:  models.AuditActionLoginFailed,
+			Details: "phone: " + phone,
+		})
 		return "", errors.Wrap(err, "can't get user by creds")
 	}
-	token, err := a.createToken(user.ID)
+	if user.Banned {
+		a.auditor.RecordOrLog(ctx, models.AuditRecord{
+			ActorID:  user.ID,
+			Action:   models.AuditActionLoginFailed,
+			TargetID: user.ID,
+			Details:  "user is banned",
+		})
+		return "", errUserBanned
+	}
+	a.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  user.ID,
+		Action:   models.AuditActionLogin,
+		TargetID: user.ID,
+	})
+	token, err := a.createToken(user)
 	if err != nil {
 		return "", errors.Wrap(err, "can't generate token for logged in user")
 	}
@@ -59,37 +102,73 @@ func (a *Authenticator) Login(ctx context.Context, phone, password string) (stri
 }
 
 func (a *Authenticator) UnpackToken(ctx context.Context, token string) (uint64, error) {
-	t, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecretKey, nil
-	})
+	user, _, err := a.unpackToken(ctx, token)
 	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+func (a *Authenticator) UnpackAdminToken(ctx context.Context, token string) (uint64, error) {
+	user, role, err := a.unpackToken(ctx, token)
+	if err != nil {
+		return 0, err
+	}
+	if role != models.UserRoleAdmin || user.Role != models.UserRoleAdmin {
 		return 0, &errs.CodableError{
 			Code:    errs.CodePermissionDenied,
-			Message: "invalid token",
+			Message: "admin role required",
+			Reason:  errs.ReasonAdminRequired,
 		}
 	}
+	return user.ID, nil
+}
+
+func (a *Authenticator) unpackToken(ctx context.Context, token string) (models.User, models.UserRole, error) {
+	t, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		return jwtSecretKey, nil
+	})
+	if err != nil {
+		return models.User{}, "", errInvalidToken
+	}
 	if !t.Valid {
-		return 0, &errs.CodableError{
-			Code:    errs.CodePermissionDenied,
-			Message: "invalid token",
-		}
+		return models.User{}, "", errInvalidToken
 	}
 
 	claimsMap, ok := t.Claims.(jwt.MapClaims)
 	if !ok {
-		return 0, &errs.CodableError{
-			Code:    errs.CodePermissionDenied,
+		return models.User{}, "", &errs.CodableError{
+			Code:    errs.CodeUnauthenticated,
 			Message: "can't read claims",
+			Reason:  errs.ReasonInvalidToken,
 		}
 	}
 	id, ok := claimsMap["user_id"].(float64)
 	if !ok {
-		return 0, &errs.CodableError{
-			Code:    errs.CodePermissionDenied,
+		return models.User{}, "", &errs.CodableError{
+			Code:    errs.CodeUnauthenticated,
 			Message: "can't read user_id",
+			Reason:  errs.ReasonInvalidToken,
+		}
+	}
+	role, _ := claimsMap["role"].(string)
+	version, _ := claimsMap["token_version"].(float64)
+
+	user, err := a.repo.GetUser(ctx, uint64(id))
+	if err != nil {
+		return models.User{}, "", errors.Wrap(err, "can't get user")
+	}
+	if user.Banned {
+		return models.User{}, "", errUserBanned
+	}
+	if user.TokenVersion != int(version) {
+		return models.User{}, "", &errs.CodableError{
+			Code:    errs.CodeUnauthenticated,
+			Message: "token revoked",
+			Reason:  errs.ReasonTokenRevoked,
 		}
 	}
-	return uint64(id), nil
+	return user, models.UserRole(role), nil
 }
 
 func getPassHash(password string) string {
@@ -98,10 +177,12 @@ func getPassHash(password string) string {
 	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
-func (a *Authenticator) createToken(userId uint64) (string, error) {
+func (a *Authenticator) createToken(user models.User) (string, error) {
 	t := jwt.NewWithClaims(jwt.SigningMethodHS256,
 		jwt.MapClaims{
-			"user_id": userId,
+			"user_id":       user.ID,
+			"role":          user.Role,
+			"token_version": user.TokenVersion,
 		},
 	)
 	token, err := t.SignedString(jwtSecretKey)
@@ -55,6 +55,21 @@ func (mr *MockRepositoryMockRecorder) CreateUser(ctx, phoneNumber, passHash any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockRepository)(nil).CreateUser), ctx, phoneNumber, passHash)
 }
 
+// GetUser mocks base method.
+func (m *MockRepository) GetUser(ctx context.Context, userID uint64) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, userID)
+}
+
 // GetUserByCreds mocks base method.
 func (m *MockRepository) GetUserByCreds(ctx context.Context, phoneNumber, passHash string) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -69,3 +84,38 @@ func (mr *MockRepositoryMockRecorder) GetUserByCreds(ctx, phoneNumber, passHash
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByCreds", reflect.TypeOf((*MockRepository)(nil).GetUserByCreds), ctx, phoneNumber, passHash)
 }
+
+// MockAuditor is a mock of Auditor interface.
+type MockAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditorMockRecorder
+}
+
+// MockAuditorMockRecorder is the mock recorder for MockAuditor.
+type MockAuditorMockRecorder struct {
+	mock *MockAuditor
+}
+
+// NewMockAuditor creates a new mock instance.
+func NewMockAuditor(ctrl *gomock.Controller) *MockAuditor {
+	mock := &MockAuditor{ctrl: ctrl}
+	mock.recorder = &MockAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditor) EXPECT() *MockAuditorMockRecorder {
+	return m.recorder
+}
+
+// RecordOrLog mocks base method.
+func (m *MockAuditor) RecordOrLog(ctx context.Context, record models.AuditRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordOrLog", ctx, record)
+}
+
+// RecordOrLog indicates an expected call of RecordOrLog.
+func (mr *MockAuditorMockRecorder) RecordOrLog(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOrLog", reflect.TypeOf((*MockAuditor)(nil).RecordOrLog), ctx, record)
+}
@@ -0,0 +1,179 @@
+package boost
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+const (
+	userIdContextKey = "user_id"
+	reportInterval   = time.Minute
+	reportTimeout    = time.Minute
+)
+
+var (
+	errUnauthenticated = &errs.CodableError{
+		Code:    errs.CodeUnauthenticated,
+		Message: "unauthenticated for this endpoint",
+		Reason:  errs.ReasonUnauthenticated,
+	}
+)
+
+type Booster struct {
+	repository Repository
+	notifier   UserNotifier
+	logger     *zerolog.Logger
+	duration   time.Duration
+	cooldown   time.Duration
+
+	finish     chan struct{}
+	finishDone chan struct{}
+}
+
+type Repository interface {
+	CreateBoost(ctx context.Context, userID uint64, startedAt, endsAt, cooldownFrom time.Time) (models.Boost, bool, error)
+	GetLatestBoost(ctx context.Context, userID uint64) (models.Boost, error)
+	GetUnreportedBoosts(ctx context.Context, endedBefore time.Time) ([]models.Boost, error)
+	ReportBoost(ctx context.Context, boostID uint64, result models.BoostResult) error
+	GetProfileStats(ctx context.Context, userID uint64, from, to time.Time) (models.ProfileStats, error)
+}
+
+type UserNotifier interface {
+	NotifyBoostFinished(ctx context.Context, userId uint64, notification models.BoostFinishedNotification) error
+}
+
+func New(repo Repository, notifier UserNotifier, duration, cooldown time.Duration, logger *zerolog.Logger) *Booster {
+	return &Booster{
+		repository: repo,
+		notifier:   notifier,
+		logger:     logger,
+		duration:   duration,
+		cooldown:   cooldown,
+		finish:     make(chan struct{}),
+		finishDone: make(chan struct{}),
+	}
+}
+
+func (b *Booster) Boost(ctx context.Context) (models.Boost, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return models.Boost{}, errUnauthenticated
+	}
+	latest, err := b.repository.GetLatestBoost(ctx, userId)
+	if err != nil {
+		return models.Boost{}, errors.Wrap(err, "can't get latest boost")
+	}
+	now := time.Now()
+	if latest.ID != 0 && now.Before(latest.StartedAt.Add(b.cooldown)) {
+		return models.Boost{}, b.cooldownError(latest)
+	}
+	boost, created, err := b.repository.CreateBoost(ctx, userId, now, now.Add(b.duration), now.Add(-b.cooldown))
+	if err != nil {
+		return models.Boost{}, errors.Wrap(err, "can't create boost")
+	}
+	if !created {
+		// a concurrent request started a boost after our check
+		latest, err = b.repository.GetLatestBoost(ctx, userId)
+		if err != nil {
+			return models.Boost{}, errors.Wrap(err, "can't get latest boost")
+		}
+		return models.Boost{}, b.cooldownError(latest)
+	}
+	return boost, nil
+}
+
+func (b *Booster) cooldownError(latest models.Boost) error {
+	availableAt := latest.StartedAt.Add(b.cooldown)
+	return &errs.CodableError{
+		Code:     errs.CodeResourceExhausted,
+		Message:  "boost is on cooldown",
+		Reason:   errs.ReasonBoostCooldown,
+		Metadata: map[string]string{"available_at": availableAt.UTC().Format(time.RFC3339)},
+	}
+}
+
+func (b *Booster) ReportFinishedBoosts(ctx context.Context) error {
+	boosts, err := b.repository.GetUnreportedBoosts(ctx, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "can't get unreported boosts")
+	}
+	for _, boost := range boosts {
+		err = b.reportBoost(ctx, boost)
+		if err != nil {
+			b.logger.Err(err).Uint64("boost_id", boost.ID).Msg("can't report boost")
+		}
+	}
+	return nil
+}
+
+func (b *Booster) reportBoost(ctx context.Context, boost models.Boost) error {
+	during, err := b.repository.GetProfileStats(ctx, boost.UserID, boost.StartedAt, boost.EndsAt)
+	if err != nil {
+		return errors.Wrap(err, "can't get stats during boost")
+	}
+	window := boost.EndsAt.Sub(boost.StartedAt)
+	baseline, err := b.repository.GetProfileStats(ctx, boost.UserID, boost.StartedAt.Add(-window), boost.StartedAt)
+	if err != nil {
+		return errors.Wrap(err, "can't get stats before boost")
+	}
+	// saved first so a failure can't lead to a second notification on the
+	// next tick
+	err = b.repository.ReportBoost(ctx, boost.ID, models.BoostResult{
+		During:   during,
+		Baseline: baseline,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't save boost result")
+	}
+	err = b.notifier.NotifyBoostFinished(ctx, boost.UserID, models.BoostFinishedNotification{
+		Views: during.Views,
+		Likes: during.Likes,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't notify boost finished")
+	}
+	b.logger.Info().
+		Uint64("user_id", boost.UserID).
+		Int("views", during.Views).
+		Int("likes", during.Likes).
+		Int("baseline_views", baseline.Views).
+		Int("baseline_likes", baseline.Likes).
+		Msg("boost finished")
+	return nil
+}
+
+func (b *Booster) Start(ctx context.Context) error {
+	ticker := time.NewTicker(reportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			close(b.finishDone)
+			return nil
+		case <-b.finish:
+			close(b.finishDone)
+			return nil
+		case <-ticker.C:
+			ctxTo, cancel := context.WithTimeout(ctx, reportTimeout)
+			err := b.ReportFinishedBoosts(ctxTo)
+			cancel()
+			if err != nil {
+				b.logger.Err(err).Msg("can't report finished boosts")
+			}
+		}
+	}
+}
+
+func (b *Booster) Stop(ctx context.Context) error {
+	close(b.finish)
+	select {
+	case <-b.finishDone:
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usecase/boost/boost.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/usecase/boost/boost.go -destination internal/usecase/boost/boost_mock_test.go -package boost
+//
+
+// Package boost is a generated GoMock package.
+package boost
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/mayye4ka/pinder/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBoost mocks base method.
+func (m *MockRepository) CreateBoost(ctx context.Context, userID uint64, startedAt, endsAt, cooldownFrom time.Time) (models.Boost, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoost", ctx, userID, startedAt, endsAt, cooldownFrom)
+	ret0, _ := ret[0].(models.Boost)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateBoost indicates an expected call of CreateBoost.
+func (mr *MockRepositoryMockRecorder) CreateBoost(ctx, userID, startedAt, endsAt, cooldownFrom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoost", reflect.TypeOf((*MockRepository)(nil).CreateBoost), ctx, userID, startedAt, endsAt, cooldownFrom)
+}
+
+// GetLatestBoost mocks base method.
+func (m *MockRepository) GetLatestBoost(ctx context.Context, userID uint64) (models.Boost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestBoost", ctx, userID)
+	ret0, _ := ret[0].(models.Boost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestBoost indicates an expected call of GetLatestBoost.
+func (mr *MockRepositoryMockRecorder) GetLatestBoost(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestBoost", reflect.TypeOf((*MockRepository)(nil).GetLatestBoost), ctx, userID)
+}
+
+// GetProfileStats mocks base method.
+func (m *MockRepository) GetProfileStats(ctx context.Context, userID uint64, from, to time.Time) (models.ProfileStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileStats", ctx, userID, from, to)
+	ret0, _ := ret[0].(models.ProfileStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileStats indicates an expected call of GetProfileStats.
+func (mr *MockRepositoryMockRecorder) GetProfileStats(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStats", reflect.TypeOf((*MockRepository)(nil).GetProfileStats), ctx, userID, from, to)
+}
+
+// GetUnreportedBoosts mocks base method.
+func (m *MockRepository) GetUnreportedBoosts(ctx context.Context, endedBefore time.Time) ([]models.Boost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreportedBoosts", ctx, endedBefore)
+	ret0, _ := ret[0].([]models.Boost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreportedBoosts indicates an expected call of GetUnreportedBoosts.
+func (mr *MockRepositoryMockRecorder) GetUnreportedBoosts(ctx, endedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreportedBoosts", reflect.TypeOf((*MockRepository)(nil).GetUnreportedBoosts), ctx, endedBefore)
+}
+
+// ReportBoost mocks base method.
+func (m *MockRepository) ReportBoost(ctx context.Context, boostID uint64, result models.BoostResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportBoost", ctx, boostID, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportBoost indicates an expected call of ReportBoost.
+func (mr *MockRepositoryMockRecorder) ReportBoost(ctx, boostID, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportBoost", reflect.TypeOf((*MockRepository)(nil).ReportBoost), ctx, boostID, result)
+}
+
+// MockUserNotifier is a mock of UserNotifier interface.
+type MockUserNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserNotifierMockRecorder
+}
+
+// MockUserNotifierMockRecorder is the mock recorder for MockUserNotifier.
+type MockUserNotifierMockRecorder struct {
+	mock *MockUserNotifier
+}
+
+// NewMockUserNotifier creates a new mock instance.
+func NewMockUserNotifier(ctrl *gomock.Controller) *MockUserNotifier {
+	mock := &MockUserNotifier{ctrl: ctrl}
+	mock.recorder = &MockUserNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserNotifier) EXPECT() *MockUserNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyBoostFinished mocks base method.
+func (m *MockUserNotifier) NotifyBoostFinished(ctx context.Context, userId uint64, notification models.BoostFinishedNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyBoostFinished", ctx, userId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyBoostFinished indicates an expected call of NotifyBoostFinished.
+func (mr *MockUserNotifierMockRecorder) NotifyBoostFinished(ctx, userId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBoostFinished", reflect.TypeOf((*MockUserNotifier)(nil).NotifyBoostFinished), ctx, userId, notification)
+}
@@ -0,0 +1,142 @@
+package boost
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	userId   = uint64(123)
+	userCtx  = context.WithValue(context.Background(), userIdContextKey, userId)
+	ctx      = context.Background()
+	duration = 30 * time.Minute
+	cooldown = 24 * time.Hour
+)
+
+type BoostTestSuite struct {
+	suite.Suite
+	repoMock     *MockRepository
+	notifierMock *MockUserNotifier
+	booster      *Booster
+}
+
+func TestBoost(t *testing.T) {
+	suite.Run(t, new(BoostTestSuite))
+}
+
+func (s *BoostTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.repoMock = NewMockRepository(ctrl)
+	s.notifierMock = NewMockUserNotifier(ctrl)
+	logger := zerolog.New(io.Discard)
+	s.booster = New(s.repoMock, s.notifierMock, duration, cooldown, &logger)
+}
+
+func (s *BoostTestSuite) TestBoost() {
+	s.repoMock.EXPECT().GetLatestBoost(userCtx, userId).Return(models.Boost{
+		ID:        1,
+		UserID:    userId,
+		StartedAt: time.Now().Add(-cooldown - time.Minute),
+	}, nil)
+	s.repoMock.EXPECT().CreateBoost(userCtx, userId, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, userID uint64, startedAt, endsAt, cooldownFrom time.Time) (models.Boost, bool, error) {
+			s.Equal(duration, endsAt.Sub(startedAt))
+			s.Equal(cooldown, startedAt.Sub(cooldownFrom))
+			return models.Boost{ID: 2, UserID: userID, StartedAt: startedAt, EndsAt: endsAt}, true, nil
+		})
+
+	boost, err := s.booster.Boost(userCtx)
+
+	s.Nil(err)
+	s.Equal(uint64(2), boost.ID)
+}
+
+func (s *BoostTestSuite) TestBoost_OnCooldown() {
+	startedAt := time.Now().Add(-time.Hour)
+	s.repoMock.EXPECT().GetLatestBoost(userCtx, userId).Return(models.Boost{
+		ID:        1,
+		UserID:    userId,
+		StartedAt: startedAt,
+	}, nil)
+
+	_, err := s.booster.Boost(userCtx)
+
+	var ce *errs.CodableError
+	s.Require().ErrorAs(err, &ce)
+	s.Equal(errs.CodeResourceExhausted, ce.Code)
+	s.Equal(errs.ReasonBoostCooldown, ce.Reason)
+	s.Equal(startedAt.Add(cooldown).UTC().Format(time.RFC3339), ce.Metadata["available_at"])
+}
+
+func (s *BoostTestSuite) TestBoost_LostCooldownRace() {
+	startedAt := time.Now()
+	s.repoMock.EXPECT().GetLatestBoost(userCtx, userId).Return(models.Boost{}, nil)
+	s.repoMock.EXPECT().CreateBoost(userCtx, userId, gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Boost{}, false, nil)
+	s.repoMock.EXPECT().GetLatestBoost(userCtx, userId).Return(models.Boost{
+		ID:        1,
+		UserID:    userId,
+		StartedAt: startedAt,
+	}, nil)
+
+	_, err := s.booster.Boost(userCtx)
+
+	var ce *errs.CodableError
+	s.Require().ErrorAs(err, &ce)
+	s.Equal(errs.ReasonBoostCooldown, ce.Reason)
+	s.Equal(startedAt.Add(cooldown).UTC().Format(time.RFC3339), ce.Metadata["available_at"])
+}
+
+func (s *BoostTestSuite) TestReportFinishedBoosts() {
+	startedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	endsAt := startedAt.Add(duration)
+	boost := models.Boost{ID: 1, UserID: userId, StartedAt: startedAt, EndsAt: endsAt}
+	during := models.ProfileStats{Views: 40, Likes: 6}
+	baseline := models.ProfileStats{Views: 10, Likes: 1}
+
+	s.repoMock.EXPECT().GetUnreportedBoosts(ctx, gomock.Any()).Return([]models.Boost{boost}, nil)
+	s.repoMock.EXPECT().GetProfileStats(ctx, userId, startedAt, endsAt).Return(during, nil)
+	s.repoMock.EXPECT().GetProfileStats(ctx, userId, startedAt.Add(-duration), startedAt).Return(baseline, nil)
+	gomock.InOrder(
+		s.repoMock.EXPECT().ReportBoost(ctx, boost.ID, models.BoostResult{
+			During:   during,
+			Baseline: baseline,
+		}).Return(nil),
+		s.notifierMock.EXPECT().NotifyBoostFinished(ctx, userId, models.BoostFinishedNotification{
+			Views: 40,
+			Likes: 6,
+		}).Return(nil),
+	)
+
+	err := s.booster.ReportFinishedBoosts(ctx)
+
+	s.Nil(err)
+}
+
+func (s *BoostTestSuite) TestReportFinishedBoosts_ContinuesAfterFailure() {
+	startedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	endsAt := startedAt.Add(duration)
+	failing := models.Boost{ID: 1, UserID: 200, StartedAt: startedAt, EndsAt: endsAt}
+	boost := models.Boost{ID: 2, UserID: userId, StartedAt: startedAt, EndsAt: endsAt}
+	stats := models.ProfileStats{Views: 5, Likes: 1}
+
+	s.repoMock.EXPECT().GetUnreportedBoosts(ctx, gomock.Any()).Return([]models.Boost{failing, boost}, nil)
+	s.repoMock.EXPECT().GetProfileStats(ctx, failing.UserID, gomock.Any(), gomock.Any()).Return(models.ProfileStats{}, &errs.CodableError{
+		Code:    errs.CodeUnavailable,
+		Message: "db is down",
+	})
+	s.repoMock.EXPECT().GetProfileStats(ctx, userId, gomock.Any(), gomock.Any()).Return(stats, nil).Times(2)
+	s.repoMock.EXPECT().ReportBoost(ctx, boost.ID, models.BoostResult{During: stats, Baseline: stats}).Return(nil)
+	s.notifierMock.EXPECT().NotifyBoostFinished(ctx, userId, models.BoostFinishedNotification{Views: 5, Likes: 1}).Return(nil)
+
+	err := s.booster.ReportFinishedBoosts(ctx)
+
+	s.Nil(err)
+}
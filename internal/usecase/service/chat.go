@@ -1,12 +1,30 @@
 package service
 
 import (
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
 	"github.com/pkg/errors"
 
 	"golang.org/x/net/context"
 )
 
+const (
+	maxTextLen   = 4096
+	maxMediaSize = 10 << 20
+
+	defaultMessagePageSize = 50
+	maxMessagePageSize     = 100
+
+	defaultChatPageSize = 30
+	maxChatPageSize     = 100
+
+	maxPreviewLen = 100
+
+	maxClientMessageIDLen = 64
+)
+
 func getWhoIsNotMe(id1, id2, userId uint64) uint64 {
 	if id1 == userId {
 		return id2
@@ -15,6 +33,9 @@ func getWhoIsNotMe(id1, id2, userId uint64) uint64 {
 }
 
 func (s *Service) enrichMessageWithLinks(ctx context.Context, message *models.Message) error {
+	if !message.DeletedAt.IsZero() {
+		return nil
+	}
 	if message.ContentType == models.ContentPhoto {
 		link, err := s.filestorage.MakeChatPhotoLink(ctx, message.Payload)
 		if err != nil {
@@ -32,78 +53,222 @@ func (s *Service) enrichMessageWithLinks(ctx context.Context, message *models.Me
 	return nil
 }
 
-func (s *Service) ListChats(ctx context.Context) ([]models.ChatShowcase, error) {
+func (s *Service) ListChats(ctx context.Context, page models.ChatPage) (models.ChatList, error) {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
-		return nil, errUnauthenticated
+		return models.ChatList{}, errUnauthenticated
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultChatPageSize
 	}
-	chats, err := s.repository.GetChats(ctx, userId)
+	if page.Limit < 0 || page.Limit > maxChatPageSize {
+		return models.ChatList{}, errs.InvalidField("bad page", "limit", "limit out of range")
+	}
+	summaries, err := s.repository.GetChatSummaries(ctx, userId, models.ChatPage{
+		Cursor: page.Cursor,
+		Limit:  page.Limit + 1,
+	})
 	if err != nil {
-		return nil, errors.Wrap(err, "can't get chats by user id")
+		return models.ChatList{}, errors.Wrap(err, "can't get chats by user id")
 	}
-	res := []models.ChatShowcase{}
-	for _, chat := range chats {
-		user2 := getWhoIsNotMe(chat.User1, chat.User2, userId)
-		prof, err := s.repository.GetProfile(ctx, user2)
-		if err != nil {
-			return nil, errors.Wrap(err, "can't get profile")
+	var nextCursor models.ChatCursor
+	if len(summaries) > page.Limit {
+		summaries = summaries[:page.Limit]
+		last := summaries[len(summaries)-1].Chat
+		nextCursor = models.ChatCursor{
+			ActivityAt: last.LastActivityAt,
+			ChatID:     last.ID,
 		}
-		photos, err := s.repository.GetUserPhotos(ctx, user2)
-		if err != nil {
-			return nil, errors.Wrap(err, "can't get user photos")
+	}
+	if len(summaries) == 0 {
+		return models.ChatList{Chats: []models.ChatShowcase{}}, nil
+	}
+	myProfile, err := s.repository.GetProfile(ctx, userId)
+	if err != nil {
+		return models.ChatList{}, errors.Wrap(err, "can't get profile")
+	}
+	partnerIds := make([]uint64, len(summaries))
+	for i, summary := range summaries {
+		partnerIds[i] = getWhoIsNotMe(summary.Chat.User1, summary.Chat.User2, userId)
+	}
+	profiles, err := s.repository.GetProfiles(ctx, partnerIds)
+	if err != nil {
+		return models.ChatList{}, errors.Wrap(err, "can't get profiles")
+	}
+	users, err := s.repository.GetUsers(ctx, partnerIds)
+	if err != nil {
+		return models.ChatList{}, errors.Wrap(err, "can't get users")
+	}
+	photos, err := s.repository.GetFirstPhotos(ctx, partnerIds)
+	if err != nil {
+		return models.ChatList{}, errors.Wrap(err, "can't get user photos")
+	}
+	now := time.Now()
+	res := make([]models.ChatShowcase, len(summaries))
+	for i, summary := range summaries {
+		partnerId := partnerIds[i]
+		prof := profiles[partnerId]
+		link := ""
+		if photo, ok := photos[partnerId]; ok {
+			link, err = s.filestorage.MakeProfilePhotoLink(ctx, photo)
+			if err != nil {
+				return models.ChatList{}, errors.Wrap(err, "can't make profile photo link")
+			}
 		}
-		link, err := s.filestorage.MakeProfilePhotoLink(ctx, photos[0])
-		if err != nil {
-			return nil, errors.Wrap(err, "can't make profile photo link")
+		distance, lastActive := proximity(myProfile, prof, users[partnerId], now)
+		res[i] = models.ChatShowcase{
+			ID:             summary.Chat.ID,
+			Name:           prof.Name,
+			Photo:          link,
+			Compatibility:  models.NewCompatibility(myProfile, prof),
+			DistanceKm:     distance,
+			LastActive:     lastActive,
+			LastMessage:    previewMessage(summary.LastMessage, userId),
+			UnreadCount:    summary.UnreadCount,
+			LastActivityAt: summary.Chat.LastActivityAt,
 		}
-		res = append(res, models.ChatShowcase{
-			ID:    chat.ID,
-			Name:  prof.Name,
-			Photo: link,
-		})
 	}
 
-	return res, nil
+	return models.ChatList{
+		Chats:      res,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func previewMessage(msg *models.Message, userId uint64) *models.MessagePreview {
+	if msg == nil {
+		return nil
+	}
+	preview := ""
+	if msg.ContentType == models.ContentText {
+		preview = msg.Payload
+		if runes := []rune(preview); len(runes) > maxPreviewLen {
+			preview = string(runes[:maxPreviewLen]) + "…"
+		}
+	}
+	return &models.MessagePreview{
+		ID:          msg.ID,
+		SentByMe:    msg.SenderID == userId,
+		ContentType: msg.ContentType,
+		Preview:     preview,
+		CreatedAt:   msg.CreatedAt,
+		Deleted:     !msg.DeletedAt.IsZero(),
+	}
+}
+
+// previewQuote previews a quoted message; one the viewer deleted for
+// themselves is shown like a message deleted for everyone.
+func previewQuote(msg *models.Message, userId uint64, hidden bool) *models.MessagePreview {
+	preview := previewMessage(msg, userId)
+	if preview != nil && hidden {
+		preview.Preview = ""
+		preview.Deleted = true
+	}
+	return preview
 }
 
-func (s *Service) ListMessages(ctx context.Context, chatId uint64) ([]models.MessageShowcase, error) {
+func (s *Service) ListMessages(ctx context.Context, chatId uint64, page models.MessagePage) (models.MessageList, error) {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
-		return nil, errUnauthenticated
+		return models.MessageList{}, errUnauthenticated
+	}
+	if page.Direction == "" {
+		page.Direction = models.PageOlder
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultMessagePageSize
+	}
+	v := errs.NewValidator("bad page")
+	v.Check(page.Direction.Valid(), "direction", "unknown direction")
+	v.Check(page.Limit > 0 && page.Limit <= maxMessagePageSize, "limit", "limit out of range")
+	if err := v.Err(); err != nil {
+		return models.MessageList{}, err
 	}
 	chat, err := s.repository.GetChat(ctx, chatId)
 	if err != nil {
-		return nil, errors.Wrap(err, "can't get chat by id")
+		return models.MessageList{}, errors.Wrap(err, "can't get chat by id")
 	}
 	if chat.User1 != userId && chat.User2 != userId {
-		return nil, errPermissionDenied
+		return models.MessageList{}, errPermissionDenied
+	}
+	messages, err := s.repository.GetMessages(ctx, chatId, userId, models.MessagePage{
+		Cursor:    page.Cursor,
+		Direction: page.Direction,
+		Limit:     page.Limit + 1,
+	})
+	if err != nil {
+		return models.MessageList{}, errors.Wrap(err, "can't get messages")
+	}
+	list := models.MessageList{}
+	if len(messages) > page.Limit {
+		if page.Direction == models.PageOlder {
+			messages = messages[1:]
+			list.NextCursor = messages[0].ID
+		} else {
+			messages = messages[:page.Limit]
+			list.NextCursor = messages[len(messages)-1].ID
+		}
+	}
+	cursors, err := s.repository.GetReadCursors(ctx, chatId)
+	if err != nil {
+		return models.MessageList{}, errors.Wrap(err, "can't get read cursors")
+	}
+	messageIds := make([]uint64, len(messages))
+	var replyToIds []uint64
+	for i, msg := range messages {
+		messageIds[i] = msg.ID
+		if msg.ReplyToID != 0 {
+			replyToIds = append(replyToIds, msg.ReplyToID)
+		}
 	}
-	messages, err := s.repository.GetMessages(ctx, chatId)
+	reactions, err := s.repository.GetReactions(ctx, messageIds)
 	if err != nil {
-		return nil, errors.Wrap(err, "can't get messages")
+		return models.MessageList{}, errors.Wrap(err, "can't get reactions")
+	}
+	quoted := map[uint64]models.Message{}
+	hidden := map[uint64]bool{}
+	if len(replyToIds) > 0 {
+		quoted, err = s.repository.GetMessagesByIDs(ctx, replyToIds)
+		if err != nil {
+			return models.MessageList{}, errors.Wrap(err, "can't get quoted messages")
+		}
+		hidden, err = s.repository.GetHiddenMessageIDs(ctx, userId, replyToIds)
+		if err != nil {
+			return models.MessageList{}, errors.Wrap(err, "can't get hidden messages")
+		}
 	}
-	res := []models.MessageShowcase{}
+	list.Messages = []models.MessageShowcase{}
 	for _, msg := range messages {
 		sentByMe := true
 		if msg.SenderID != userId {
 			sentByMe = false
 		}
+		recipient := getWhoIsNotMe(chat.User1, chat.User2, msg.SenderID)
 		err = s.enrichMessageWithLinks(ctx, &msg)
 		if err != nil {
-			return nil, errors.Wrap(err, "can't enrich message with links")
+			return models.MessageList{}, errors.Wrap(err, "can't enrich message with links")
 		}
-		res = append(res, models.MessageShowcase{
+		var replyTo *models.MessagePreview
+		if q, ok := quoted[msg.ReplyToID]; ok {
+			replyTo = previewQuote(&q, userId, hidden[q.ID])
+		}
+		list.Messages = append(list.Messages, models.MessageShowcase{
 			ID:          msg.ID,
 			SentByMe:    sentByMe,
 			ContentType: msg.ContentType,
 			Payload:     msg.Payload,
 			CreatedAt:   msg.CreatedAt,
+			Read:        cursors[recipient] >= msg.ID,
+			Edited:      !msg.EditedAt.IsZero(),
+			Deleted:     !msg.DeletedAt.IsZero(),
+			Reactions:   reactionsToShowcase(reactions[msg.ID], userId),
+			ReplyTo:     replyTo,
 		})
 	}
-	return res, nil
+	return list, nil
 }
 
-func (s *Service) SendMessage(ctx context.Context, chatId uint64, contentType models.MsgContentType, payload string) error {
+func (s *Service) MarkRead(ctx context.Context, chatId, upToMessageId uint64) error {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
 		return errUnauthenticated
@@ -115,42 +280,148 @@ func (s *Service) SendMessage(ctx context.Context, chatId uint64, contentType mo
 	if chat.User1 != userId && chat.User2 != userId {
 		return errPermissionDenied
 	}
+	msg, err := s.repository.GetMessage(ctx, upToMessageId)
+	if err != nil {
+		return errors.Wrap(err, "can't get message")
+	}
+	if msg.ChatID != chatId {
+		return errs.InvalidField("bad read receipt", "message_id", "message is not in this chat")
+	}
+	advanced, err := s.repository.MarkRead(ctx, chatId, userId, upToMessageId)
+	if err != nil {
+		return errors.Wrap(err, "can't mark messages as read")
+	}
+	if !advanced {
+		return nil
+	}
+	err = s.userNotifier.NotifyRead(ctx, getWhoIsNotMe(chat.User1, chat.User2, userId), models.ReadReceipt{
+		ChatID:        chatId,
+		UpToMessageID: upToMessageId,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't notify read receipt")
+	}
+	return nil
+}
+
+func (s *Service) SendMessage(ctx context.Context, outgoing models.OutgoingMessage) (uint64, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return 0, errUnauthenticated
+	}
+	contentType, payload := outgoing.ContentType, outgoing.Payload
+	v := errs.NewValidator("bad message")
+	v.Check(contentType.Valid(), "content_type", "unknown content type")
+	v.Check(payload != "", "payload", "empty payload")
+	v.Check(contentType != models.ContentText || len(payload) <= maxTextLen, "payload", "text too long")
+	v.Check(contentType == models.ContentText || len(payload) <= maxMediaSize, "payload", "media too large")
+	v.Check(len(outgoing.ClientMessageID) <= maxClientMessageIDLen, "client_message_id", "client message id too long")
+	if err := v.Err(); err != nil {
+		return 0, err
+	}
+	chat, err := s.repository.GetChat(ctx, outgoing.ChatID)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get chat")
+	}
+	if chat.User1 != userId && chat.User2 != userId {
+		return 0, errPermissionDenied
+	}
+	if outgoing.ClientMessageID != "" {
+		sentId, found, err := s.findSentMessage(ctx, userId, outgoing)
+		if err != nil || found {
+			return sentId, err
+		}
+	}
+	var quoted *models.Message
+	if outgoing.ReplyToID != 0 {
+		replyTo, err := s.repository.GetMessage(ctx, outgoing.ReplyToID)
+		if err != nil {
+			return 0, errors.Wrap(err, "can't get quoted message")
+		}
+		if replyTo.ChatID != outgoing.ChatID {
+			return 0, errs.InvalidField("bad message", "reply_to_id", "quoted message is not in this chat")
+		}
+		quoted = &replyTo
+	}
 	if contentType == models.ContentVoice {
 		key, err := s.filestorage.SaveChatVoice(ctx, []byte(payload))
 		if err != nil {
-			return errors.Wrap(err, "can't save chat voice")
+			return 0, errors.Wrap(err, "can't save chat voice")
 		}
-		payload = key
+		outgoing.Payload = key
 	} else if contentType == models.ContentPhoto {
 		key, err := s.filestorage.SaveChatPhoto(ctx, []byte(payload))
 		if err != nil {
-			return errors.Wrap(err, "can't save chat photo")
+			return 0, errors.Wrap(err, "can't save chat photo")
+		}
+		outgoing.Payload = key
+	}
+	msg, err := s.repository.SendMessage(ctx, userId, outgoing)
+	if err != nil && outgoing.ClientMessageID != "" && errs.CodeOf(err) == errs.CodeAlreadyExists {
+		// A concurrent retry of the same message won the insert; the media
+		// uploaded here is not referenced by anything, and losing it to a
+		// failed delete is only wasted space.
+		_ = s.delMessageMedia(ctx, contentType, outgoing.Payload)
+		sentId, found, lookupErr := s.findSentMessage(ctx, userId, outgoing)
+		if lookupErr != nil || found {
+			return sentId, lookupErr
 		}
-		payload = key
 	}
-	msg, err := s.repository.SendMessage(ctx, chatId, userId, contentType, payload)
 	if err != nil {
-		return errors.Wrap(err, "can't send message")
+		return 0, errors.Wrap(err, "can't send message")
 	}
 	err = s.enrichMessageWithLinks(ctx, &msg)
 	if err != nil {
-		return errors.Wrap(err, "can't enrich message with links")
+		return 0, errors.Wrap(err, "can't enrich message with links")
 	}
 	for _, recv := range []uint64{chat.User1, chat.User2} {
 		sentByMe := true
 		if recv != userId {
 			sentByMe = false
 		}
+		hidden := false
+		if quoted != nil {
+			hiddenIds, err := s.repository.GetHiddenMessageIDs(ctx, recv, []uint64{quoted.ID})
+			if err != nil {
+				return 0, errors.Wrap(err, "can't get hidden messages")
+			}
+			hidden = hiddenIds[quoted.ID]
+		}
 		err = s.userNotifier.SendMessage(ctx, recv, models.MessageSend{
 			ChatID:      msg.ChatID,
 			MessageID:   msg.ID,
 			SentByMe:    sentByMe,
 			ContentType: msg.ContentType,
 			Payload:     msg.Payload,
+			ReplyTo:     previewQuote(quoted, recv, hidden),
 		})
 		if err != nil {
-			return errors.Wrap(err, "can't send message")
+			return 0, errors.Wrap(err, "can't send message")
 		}
 	}
+	return msg.ID, nil
+}
+
+func (s *Service) findSentMessage(ctx context.Context, userId uint64, outgoing models.OutgoingMessage) (uint64, bool, error) {
+	sent, found, err := s.repository.GetMessageByClientID(ctx, userId, outgoing.ClientMessageID)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "can't get message by client id")
+	}
+	if !found {
+		return 0, false, nil
+	}
+	if sent.ChatID != outgoing.ChatID {
+		return 0, true, errs.InvalidField("bad message", "client_message_id", "client message id already used in another chat")
+	}
+	return sent.ID, true, nil
+}
+
+func (s *Service) delMessageMedia(ctx context.Context, contentType models.MsgContentType, key string) error {
+	switch contentType {
+	case models.ContentPhoto:
+		return s.filestorage.DelChatPhoto(ctx, key)
+	case models.ContentVoice:
+		return s.filestorage.DelChatVoice(ctx, key)
+	}
 	return nil
 }
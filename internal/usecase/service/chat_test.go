@@ -1,7 +1,12 @@
 package service
 
 import (
+	"strings"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
+	"go.uber.org/mock/gomock"
 )
 
 var (
@@ -65,34 +70,249 @@ var (
 )
 
 func (s *ServiceTestSuite) TestListChats() {
-	s.repoMock.EXPECT().GetChats(user1Ctx, userId).Return([]models.Chat{chat}, nil)
-	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(models.Profile{UserID: user2Id, Name: userName}, nil)
-	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{photo1, photo2}, nil)
+	me := profile
+	me.Interests = []string{"hiking", "music"}
+	me.Languages = []string{"en"}
+	activityAt := time.Now().Add(-time.Minute)
+	lastMessage := msgText
+	lastMessage.SenderID = user2Id
+	lastMessage.CreatedAt = activityAt
+	summary := models.ChatSummary{
+		Chat:        chat,
+		LastMessage: &lastMessage,
+		UnreadCount: 2,
+	}
+	summary.Chat.LastActivityAt = activityAt
+	s.repoMock.EXPECT().GetChatSummaries(user1Ctx, userId, models.ChatPage{
+		Limit: defaultChatPageSize + 1,
+	}).Return([]models.ChatSummary{summary}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(me, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{
+		user2Id: {
+			UserID:      user2Id,
+			Name:        userName,
+			LocationLat: profile.LocationLat,
+			LocationLon: profile.LocationLon,
+			Interests:   []string{"hiking"},
+			Languages:   []string{"en", "fr"},
+		},
+	}, nil)
+	s.repoMock.EXPECT().GetUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.User{
+		user2Id: {
+			ID:           user2Id,
+			LastActiveAt: time.Now().Add(-time.Hour),
+		},
+	}, nil)
+	s.repoMock.EXPECT().GetFirstPhotos(user1Ctx, []uint64{user2Id}).Return(map[uint64]string{user2Id: photo1}, nil)
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo1).Return(photo1Link, nil)
 
-	chats, err := s.service.ListChats(user1Ctx)
+	list, err := s.service.ListChats(user1Ctx, models.ChatPage{})
 
+	expected := chatShowcase
+	expected.Compatibility = models.Compatibility{
+		Score:           80,
+		SharedInterests: []string{"hiking"},
+		SharedLanguages: []string{"en"},
+	}
+	expected.DistanceKm = 1
+	expected.LastActive = models.ActivityToday
+	expected.LastMessage = &models.MessagePreview{
+		ID:          lastMessage.ID,
+		ContentType: models.ContentText,
+		Preview:     lastMessage.Payload,
+		CreatedAt:   activityAt,
+	}
+	expected.UnreadCount = 2
+	expected.LastActivityAt = activityAt
 	s.Nil(err)
-	s.Equal([]models.ChatShowcase{chatShowcase}, chats)
+	s.Equal(models.ChatList{Chats: []models.ChatShowcase{expected}}, list)
+}
+
+func (s *ServiceTestSuite) TestListChats_NextPage() {
+	newer := time.Now().Add(-time.Minute)
+	older := newer.Add(-time.Hour)
+	cursor := models.ChatCursor{ActivityAt: time.Now(), ChatID: 9}
+	chat2 := models.Chat{ID: 2, User1: 125, User2: userId, LastActivityAt: older}
+	chat1 := chat
+	chat1.LastActivityAt = newer
+	longText := msgPhoto
+	longText.ContentType = models.ContentText
+	longText.Payload = strings.Repeat("ж", maxPreviewLen+1)
+	s.repoMock.EXPECT().GetChatSummaries(user1Ctx, userId, models.ChatPage{
+		Cursor: cursor,
+		Limit:  2,
+	}).Return([]models.ChatSummary{
+		{Chat: chat1, LastMessage: &longText},
+		{Chat: chat2},
+	}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{}, nil)
+	s.repoMock.EXPECT().GetUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.User{}, nil)
+	s.repoMock.EXPECT().GetFirstPhotos(user1Ctx, []uint64{user2Id}).Return(map[uint64]string{}, nil)
+
+	list, err := s.service.ListChats(user1Ctx, models.ChatPage{Cursor: cursor, Limit: 1})
+
+	s.Nil(err)
+	s.Equal(models.ChatCursor{ActivityAt: newer, ChatID: chat1.ID}, list.NextCursor)
+	s.Require().Len(list.Chats, 1)
+	s.Equal(chat1.ID, list.Chats[0].ID)
+	s.Empty(list.Chats[0].Photo)
+	s.Equal(&models.MessagePreview{
+		ID:          longText.ID,
+		SentByMe:    true,
+		ContentType: models.ContentText,
+		Preview:     strings.Repeat("ж", maxPreviewLen) + "…",
+	}, list.Chats[0].LastMessage)
+}
+
+func (s *ServiceTestSuite) TestListChats_BadLimit() {
+	_, err := s.service.ListChats(user1Ctx, models.ChatPage{Limit: maxChatPageSize + 1})
+
+	s.ErrorContains(err, "limit out of range")
 }
 
 func (s *ServiceTestSuite) TestListMessages() {
 	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
-	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID).Return([]models.Message{msgText, msgPhoto, msgVoice}, nil)
+	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID, userId, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     defaultMessagePageSize + 1,
+	}).Return([]models.Message{msgText, msgPhoto, msgVoice}, nil)
+	s.repoMock.EXPECT().GetReadCursors(user1Ctx, chat.ID).Return(map[uint64]uint64{user2Id: msgPhoto.ID}, nil)
+	s.repoMock.EXPECT().GetReactions(user1Ctx, []uint64{msgText.ID, msgPhoto.ID, msgVoice.ID}).Return(map[uint64][]models.Reaction{
+		msgText.ID: {{MessageID: msgText.ID, UserID: user2Id, Emoji: "❤️"}},
+	}, nil)
 	s.fsMock.EXPECT().MakeChatPhotoLink(user1Ctx, msgPhoto.Payload).Return(chatPhotoLink, nil)
 	s.fsMock.EXPECT().MakeChatVoiceLink(user1Ctx, msgVoice.Payload).Return(voiceLink, nil)
 
-	messages, err := s.service.ListMessages(user1Ctx, chat.ID)
+	list, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{})
+
+	readText := msgTextShowcase
+	readText.Read = true
+	readText.Reactions = []models.ReactionShowcase{{Emoji: "❤️"}}
+	readPhoto := msgPhotoShowcase
+	readPhoto.Read = true
+	s.Nil(err)
+	s.Equal(models.MessageList{
+		Messages: []models.MessageShowcase{readText, readPhoto, msgVoiceShowcase},
+	}, list)
+}
+
+func (s *ServiceTestSuite) TestListMessages_OlderPage() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID, userId, models.MessagePage{
+		Cursor:    10,
+		Direction: models.PageOlder,
+		Limit:     3,
+	}).Return([]models.Message{msgText, msgPhoto, msgVoice}, nil)
+	s.repoMock.EXPECT().GetReadCursors(user1Ctx, chat.ID).Return(map[uint64]uint64{}, nil)
+	s.repoMock.EXPECT().GetReactions(user1Ctx, []uint64{msgPhoto.ID, msgVoice.ID}).Return(map[uint64][]models.Reaction{}, nil)
+	s.fsMock.EXPECT().MakeChatVoiceLink(user1Ctx, msgVoice.Payload).Return(voiceLink, nil)
+	s.fsMock.EXPECT().MakeChatPhotoLink(user1Ctx, msgPhoto.Payload).Return(chatPhotoLink, nil)
+
+	list, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{
+		Cursor:    10,
+		Direction: models.PageOlder,
+		Limit:     2,
+	})
+
+	s.Nil(err)
+	s.Equal(models.MessageList{
+		Messages:   []models.MessageShowcase{msgPhotoShowcase, msgVoiceShowcase},
+		NextCursor: msgPhoto.ID,
+	}, list)
+}
+
+func (s *ServiceTestSuite) TestListMessages_NewerPage() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID, userId, models.MessagePage{
+		Direction: models.PageNewer,
+		Limit:     3,
+	}).Return([]models.Message{msgText, msgPhoto, msgVoice}, nil)
+	s.repoMock.EXPECT().GetReadCursors(user1Ctx, chat.ID).Return(map[uint64]uint64{}, nil)
+	s.repoMock.EXPECT().GetReactions(user1Ctx, []uint64{msgText.ID, msgPhoto.ID}).Return(map[uint64][]models.Reaction{}, nil)
+	s.fsMock.EXPECT().MakeChatPhotoLink(user1Ctx, msgPhoto.Payload).Return(chatPhotoLink, nil)
+
+	list, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{
+		Direction: models.PageNewer,
+		Limit:     2,
+	})
+
+	s.Nil(err)
+	s.Equal(models.MessageList{
+		Messages:   []models.MessageShowcase{msgTextShowcase, msgPhotoShowcase},
+		NextCursor: msgPhoto.ID,
+	}, list)
+}
+
+func (s *ServiceTestSuite) TestListMessages_QuotedReply() {
+	reply := msgText
+	reply.ID = 4
+	reply.SenderID = user2Id
+	reply.ReplyToID = msgPhoto.ID
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID, userId, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     defaultMessagePageSize + 1,
+	}).Return([]models.Message{reply}, nil)
+	s.repoMock.EXPECT().GetReadCursors(user1Ctx, chat.ID).Return(map[uint64]uint64{}, nil)
+	s.repoMock.EXPECT().GetReactions(user1Ctx, []uint64{reply.ID}).Return(map[uint64][]models.Reaction{}, nil)
+	s.repoMock.EXPECT().GetMessagesByIDs(user1Ctx, []uint64{msgPhoto.ID}).Return(map[uint64]models.Message{msgPhoto.ID: msgPhoto}, nil)
+	s.repoMock.EXPECT().GetHiddenMessageIDs(user1Ctx, userId, []uint64{msgPhoto.ID}).Return(map[uint64]bool{}, nil)
+
+	list, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{})
 
 	s.Nil(err)
-	s.Equal(messages, []models.MessageShowcase{
-		msgTextShowcase, msgPhotoShowcase, msgVoiceShowcase,
+	s.Require().Len(list.Messages, 1)
+	s.Equal(&models.MessagePreview{
+		ID:          msgPhoto.ID,
+		SentByMe:    true,
+		ContentType: models.ContentPhoto,
+	}, list.Messages[0].ReplyTo)
+}
+
+func (s *ServiceTestSuite) TestListMessages_QuotedReplyHidden() {
+	reply := msgText
+	reply.ID = 4
+	reply.SenderID = user2Id
+	reply.ReplyToID = msgText.ID
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessages(user1Ctx, chat.ID, userId, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     defaultMessagePageSize + 1,
+	}).Return([]models.Message{reply}, nil)
+	s.repoMock.EXPECT().GetReadCursors(user1Ctx, chat.ID).Return(map[uint64]uint64{}, nil)
+	s.repoMock.EXPECT().GetReactions(user1Ctx, []uint64{reply.ID}).Return(map[uint64][]models.Reaction{}, nil)
+	s.repoMock.EXPECT().GetMessagesByIDs(user1Ctx, []uint64{msgText.ID}).Return(map[uint64]models.Message{msgText.ID: msgText}, nil)
+	s.repoMock.EXPECT().GetHiddenMessageIDs(user1Ctx, userId, []uint64{msgText.ID}).Return(map[uint64]bool{msgText.ID: true}, nil)
+
+	list, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{})
+
+	s.Nil(err)
+	s.Require().Len(list.Messages, 1)
+	s.Equal(&models.MessagePreview{
+		ID:          msgText.ID,
+		SentByMe:    true,
+		ContentType: models.ContentText,
+		Deleted:     true,
+	}, list.Messages[0].ReplyTo)
+}
+
+func (s *ServiceTestSuite) TestListMessages_InvalidPage() {
+	_, err := s.service.ListMessages(user1Ctx, chat.ID, models.MessagePage{
+		Direction: "sideways",
+		Limit:     1000,
 	})
+
+	s.Equal("bad page: unknown direction; limit out of range", err.Error())
 }
 
 func (s *ServiceTestSuite) TestSendMessage_ContentTypeText() {
 	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
-	s.repoMock.EXPECT().SendMessage(user1Ctx, chat.ID, userId, models.ContentText, "text").Return(msgText, nil)
+	s.repoMock.EXPECT().SendMessage(user1Ctx, userId, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+	}).Return(msgText, nil)
 	s.userNotifierMock.EXPECT().SendMessage(user1Ctx, chat.User1, models.MessageSend{
 		ChatID:      chat.ID,
 		MessageID:   msgText.ID,
@@ -108,15 +328,24 @@ func (s *ServiceTestSuite) TestSendMessage_ContentTypeText() {
 		Payload:     msgText.Payload,
 	}).Return(nil)
 
-	err := s.service.SendMessage(user1Ctx, chat.ID, models.ContentText, "text")
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+	})
 
 	s.Nil(err)
+	s.Equal(msgText.ID, id)
 }
 
 func (s *ServiceTestSuite) TestSendMessage_ContentTypeVoice() {
 	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
 	s.fsMock.EXPECT().SaveChatVoice(user1Ctx, voiceBytes).Return(voice, nil)
-	s.repoMock.EXPECT().SendMessage(user1Ctx, chat.ID, userId, models.ContentVoice, voice).Return(msgVoice, nil)
+	s.repoMock.EXPECT().SendMessage(user1Ctx, userId, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentVoice,
+		Payload:     voice,
+	}).Return(msgVoice, nil)
 	s.fsMock.EXPECT().MakeChatVoiceLink(user1Ctx, voice).Return(voiceLink, nil)
 
 	s.userNotifierMock.EXPECT().SendMessage(user1Ctx, chat.User1, models.MessageSend{
@@ -134,15 +363,24 @@ func (s *ServiceTestSuite) TestSendMessage_ContentTypeVoice() {
 		Payload:     voiceLink,
 	}).Return(nil)
 
-	err := s.service.SendMessage(user1Ctx, chat.ID, models.ContentVoice, string(voiceBytes))
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentVoice,
+		Payload:     string(voiceBytes),
+	})
 
 	s.Nil(err)
+	s.Equal(msgVoice.ID, id)
 }
 
 func (s *ServiceTestSuite) TestSendMessage_ContentTypePhoto() {
 	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
 	s.fsMock.EXPECT().SaveChatPhoto(user1Ctx, photoBytes).Return(chatPhoto, nil)
-	s.repoMock.EXPECT().SendMessage(user1Ctx, chat.ID, userId, models.ContentPhoto, chatPhoto).Return(msgPhoto, nil)
+	s.repoMock.EXPECT().SendMessage(user1Ctx, userId, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentPhoto,
+		Payload:     chatPhoto,
+	}).Return(msgPhoto, nil)
 	s.fsMock.EXPECT().MakeChatPhotoLink(user1Ctx, chatPhoto).Return(chatPhotoLink, nil)
 
 	s.userNotifierMock.EXPECT().SendMessage(user1Ctx, chat.User1, models.MessageSend{
@@ -160,7 +398,192 @@ func (s *ServiceTestSuite) TestSendMessage_ContentTypePhoto() {
 		Payload:     chatPhotoLink,
 	}).Return(nil)
 
-	err := s.service.SendMessage(user1Ctx, chat.ID, models.ContentPhoto, string(photoBytes))
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentPhoto,
+		Payload:     string(photoBytes),
+	})
 
 	s.Nil(err)
+	s.Equal(msgPhoto.ID, id)
+}
+
+func (s *ServiceTestSuite) TestSendMessage_Invalid() {
+	_, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: "sticker",
+	})
+
+	s.Equal("bad message: unknown content type; empty payload", err.Error())
+}
+
+func (s *ServiceTestSuite) TestSendMessage_Reply() {
+	reply := msgText
+	reply.ID = 4
+	reply.ReplyToID = msgText.ID
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().SendMessage(user1Ctx, userId, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+		ReplyToID:   msgText.ID,
+	}).Return(reply, nil)
+	// user2 deleted the quoted message for themselves
+	s.repoMock.EXPECT().GetHiddenMessageIDs(user1Ctx, userId, []uint64{msgText.ID}).Return(map[uint64]bool{}, nil)
+	s.repoMock.EXPECT().GetHiddenMessageIDs(user1Ctx, user2Id, []uint64{msgText.ID}).Return(map[uint64]bool{msgText.ID: true}, nil)
+	s.userNotifierMock.EXPECT().SendMessage(user1Ctx, userId, models.MessageSend{
+		ChatID:      chat.ID,
+		MessageID:   reply.ID,
+		SentByMe:    true,
+		ContentType: models.ContentText,
+		Payload:     "text",
+		ReplyTo: &models.MessagePreview{
+			ID:          msgText.ID,
+			SentByMe:    true,
+			ContentType: models.ContentText,
+			Preview:     msgText.Payload,
+		},
+	}).Return(nil)
+	s.userNotifierMock.EXPECT().SendMessage(user1Ctx, user2Id, models.MessageSend{
+		ChatID:      chat.ID,
+		MessageID:   reply.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+		ReplyTo: &models.MessagePreview{
+			ID:          msgText.ID,
+			ContentType: models.ContentText,
+			Deleted:     true,
+		},
+	}).Return(nil)
+
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+		ReplyToID:   msgText.ID,
+	})
+
+	s.Nil(err)
+	s.Equal(reply.ID, id)
+}
+
+func (s *ServiceTestSuite) TestSendMessage_ReplyToOtherChat() {
+	other := msgText
+	other.ChatID = chat.ID + 1
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, other.ID).Return(other, nil)
+
+	_, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:      chat.ID,
+		ContentType: models.ContentText,
+		Payload:     "text",
+		ReplyToID:   other.ID,
+	})
+
+	s.Equal("bad message: quoted message is not in this chat", err.Error())
+}
+
+func (s *ServiceTestSuite) TestSendMessage_DuplicateClientID() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessageByClientID(user1Ctx, userId, "c-1").Return(msgPhoto, true, nil)
+
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:          chat.ID,
+		ContentType:     models.ContentPhoto,
+		Payload:         string(photoBytes),
+		ClientMessageID: "c-1",
+	})
+
+	s.Nil(err)
+	s.Equal(msgPhoto.ID, id)
+}
+
+func (s *ServiceTestSuite) TestSendMessage_ConcurrentRetry() {
+	outgoing := models.OutgoingMessage{
+		ChatID:          chat.ID,
+		ContentType:     models.ContentPhoto,
+		Payload:         chatPhoto,
+		ClientMessageID: "c-1",
+	}
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	gomock.InOrder(
+		s.repoMock.EXPECT().GetMessageByClientID(user1Ctx, userId, "c-1").Return(models.Message{}, false, nil),
+		s.repoMock.EXPECT().GetMessageByClientID(user1Ctx, userId, "c-1").Return(msgPhoto, true, nil),
+	)
+	s.fsMock.EXPECT().SaveChatPhoto(user1Ctx, photoBytes).Return(chatPhoto, nil)
+	s.repoMock.EXPECT().SendMessage(user1Ctx, userId, outgoing).Return(models.Message{}, &errs.CodableError{
+		Code:    errs.CodeAlreadyExists,
+		Message: "message with this client id already sent",
+	})
+	s.fsMock.EXPECT().DelChatPhoto(user1Ctx, chatPhoto).Return(nil)
+
+	id, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:          chat.ID,
+		ContentType:     models.ContentPhoto,
+		Payload:         string(photoBytes),
+		ClientMessageID: "c-1",
+	})
+
+	s.Nil(err)
+	s.Equal(msgPhoto.ID, id)
+}
+
+func (s *ServiceTestSuite) TestSendMessage_ClientIDUsedInOtherChat() {
+	other := msgText
+	other.ChatID = chat.ID + 1
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessageByClientID(user1Ctx, userId, "c-1").Return(other, true, nil)
+
+	_, err := s.service.SendMessage(user1Ctx, models.OutgoingMessage{
+		ChatID:          chat.ID,
+		ContentType:     models.ContentText,
+		Payload:         "text",
+		ClientMessageID: "c-1",
+	})
+
+	s.Equal("bad message: client message id already used in another chat", err.Error())
+}
+
+func (s *ServiceTestSuite) TestMarkRead() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgVoice.ID).Return(msgVoice, nil)
+	s.repoMock.EXPECT().MarkRead(user1Ctx, chat.ID, userId, msgVoice.ID).Return(true, nil)
+	s.userNotifierMock.EXPECT().NotifyRead(user1Ctx, user2Id, models.ReadReceipt{
+		ChatID:        chat.ID,
+		UpToMessageID: msgVoice.ID,
+	}).Return(nil)
+
+	err := s.service.MarkRead(user1Ctx, chat.ID, msgVoice.ID)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestMarkRead_AlreadyRead() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().MarkRead(user1Ctx, chat.ID, userId, msgText.ID).Return(false, nil)
+
+	err := s.service.MarkRead(user1Ctx, chat.ID, msgText.ID)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestMarkRead_MessageFromOtherChat() {
+	other := msgText
+	other.ChatID = chat.ID + 1
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, other.ID).Return(other, nil)
+
+	err := s.service.MarkRead(user1Ctx, chat.ID, other.ID)
+
+	s.Equal("bad read receipt: message is not in this chat", err.Error())
+}
+
+func (s *ServiceTestSuite) TestMarkRead_NotParticipant() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(models.Chat{ID: chat.ID, User1: 200, User2: 201}, nil)
+
+	err := s.service.MarkRead(user1Ctx, chat.ID, msgText.ID)
+
+	s.Equal(errPermissionDenied, err)
 }
@@ -11,6 +11,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+var boostBonus = (len(models.PreferenceCriteria) + 1) * models.CompletenessLevels
+
 func (s *Service) NextPartner(ctx context.Context) (models.ProfileShowcase, error) {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
@@ -26,13 +28,26 @@ func (s *Service) NextPartner(ctx context.Context) (models.ProfileShowcase, erro
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't get preferences")
 	}
 	if myProfile.UserID == 0 || myPrefs.UserID == 0 {
+		photos, err := s.repository.GetUserPhotos(ctx, userId)
+		if err != nil {
+			return models.ProfileShowcase{}, errors.Wrap(err, "can't get user photos")
+		}
+		completeness := models.NewCompleteness(myProfile, myPrefs, len(photos))
+		missing := joinItems(completeness.MissingRequired)
 		return models.ProfileShowcase{}, &errs.CodableError{
-			Code:    errs.CodeInvalidInput,
-			Message: "incomplete profile",
+			Code:     errs.CodeFailedPrecondition,
+			Message:  "incomplete profile: missing " + missing,
+			Reason:   errs.ReasonIncompleteProfile,
+			Metadata: map[string]string{"missing": missing},
 		}
 	}
+	myTravel, err := s.getTravelMode(ctx, userId)
+	if err != nil {
+		return models.ProfileShowcase{}, errors.Wrap(err, "can't get travel mode")
+	}
+	myProfile = myTravel.ViewFrom(myProfile)
 
-	partner, err := s.submitHangingPartner(ctx, userId)
+	partner, err := s.submitHangingPartner(ctx, myProfile)
 	if err != nil {
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't submit hanging partner")
 	}
@@ -40,7 +55,7 @@ func (s *Service) NextPartner(ctx context.Context) (models.ProfileShowcase, erro
 		return *partner, nil
 	}
 
-	partner, err = s.submitWhoLikedMe(ctx, userId)
+	partner, err = s.submitWhoLikedMe(ctx, myProfile)
 	if err != nil {
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't submit who liked me")
 	}
@@ -48,43 +63,44 @@ func (s *Service) NextPartner(ctx context.Context) (models.ProfileShowcase, erro
 		return *partner, nil
 	}
 
-	partner, err = s.chooseCandidateAndCreateNewPair(ctx, userId)
+	partner, err = s.chooseCandidateAndCreateNewPair(ctx, myProfile)
 	if err != nil {
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't choose candidate and create new pair")
 	}
 	if partner == nil {
 		return models.ProfileShowcase{}, &errs.CodableError{
-			Code:    errs.CodeNotFound,
+			Code:    errs.CodeFailedPrecondition,
 			Message: "lower your expectations to zero",
+			Reason:  errs.ReasonNoCandidates,
 		}
 	}
 	return *partner, nil
 }
 
-func (s *Service) submitHangingPartner(ctx context.Context, userID uint64) (*models.ProfileShowcase, error) {
-	hp, err := s.getHangingPartner(ctx, userID)
+func (s *Service) submitHangingPartner(ctx context.Context, me models.Profile) (*models.ProfileShowcase, error) {
+	hp, err := s.getHangingPartner(ctx, me.UserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't get hanging partner")
 	}
 	if hp == 0 {
 		return nil, nil
 	}
-	prof, err := s.createProfileShowcase(ctx, hp)
+	prof, err := s.createProfileShowcase(ctx, me, hp)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create profile showcase")
 	}
 	return &prof, nil
 }
 
-func (s *Service) submitWhoLikedMe(ctx context.Context, userID uint64) (*models.ProfileShowcase, error) {
-	liker, err := s.repository.GetWhoLikedMe(ctx, userID)
+func (s *Service) submitWhoLikedMe(ctx context.Context, me models.Profile) (*models.ProfileShowcase, error) {
+	liker, err := s.repository.GetWhoLikedMe(ctx, me.UserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't get who liked me")
 	}
 	if liker == 0 {
 		return nil, nil
 	}
-	pa, err := s.repository.GetLatestPairAttempt(ctx, liker, userID)
+	pa, err := s.repository.GetLatestPairAttempt(ctx, liker, me.UserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't get latest pair attempt")
 	}
@@ -92,28 +108,57 @@ func (s *Service) submitWhoLikedMe(ctx context.Context, userID uint64) (*models.
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create event")
 	}
-	prof, err := s.createProfileShowcase(ctx, liker)
+	prof, err := s.createProfileShowcase(ctx, me, liker)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create profile showcase")
 	}
 	return &prof, nil
 }
 
-func (s *Service) createProfileShowcase(ctx context.Context, candidateId uint64) (models.ProfileShowcase, error) {
+func (s *Service) createProfileShowcase(ctx context.Context, me models.Profile, candidateId uint64) (models.ProfileShowcase, error) {
 	profile, err := s.repository.GetProfile(ctx, candidateId)
 	if err != nil {
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't get profile")
 	}
+	travel, err := s.getTravelMode(ctx, candidateId)
+	if err != nil {
+		return models.ProfileShowcase{}, errors.Wrap(err, "can't get travel mode")
+	}
+	profile = travel.ShownAt(profile)
 	photos, err := s.getUserPhotos(ctx, candidateId)
 	if err != nil {
 		return models.ProfileShowcase{}, errors.Wrap(err, "can't get user photos")
 	}
+	distance, lastActive, err := s.getProximity(ctx, me, profile)
+	if err != nil {
+		return models.ProfileShowcase{}, errors.Wrap(err, "can't get proximity")
+	}
 	return models.ProfileShowcase{
-		Profile: profile,
-		Photos:  photos,
+		Profile:       profile,
+		Photos:        photos,
+		Compatibility: models.NewCompatibility(me, profile),
+		DistanceKm:    distance,
+		LastActive:    lastActive,
 	}, nil
 }
 
+func (s *Service) getProximity(ctx context.Context, me, other models.Profile) (int, models.ActivityBucket, error) {
+	user, err := s.repository.GetUser(ctx, other.UserID)
+	if err != nil {
+		return 0, models.ActivityUnknown, errors.Wrap(err, "can't get user")
+	}
+	distance, lastActive := proximity(me, other, user, time.Now())
+	return distance, lastActive, nil
+}
+
+func proximity(me, other models.Profile, user models.User, now time.Time) (int, models.ActivityBucket) {
+	distance := 0
+	if dst, err := me.DistanceKm(other); err == nil {
+		distance = models.RoundDistanceKm(dst)
+	}
+	return distance, models.NewActivityBucket(user.LastActiveAt, now)
+}
+
 func (s *Service) getHangingPartner(ctx context.Context, userID uint64) (uint64, error) {
 	pas, err := s.repository.GetPendingPairAttempts(ctx, userID)
 	if err != nil {
@@ -131,15 +176,15 @@ func (s *Service) getHangingPartner(ctx context.Context, userID uint64) (uint64,
 	return 0, nil
 }
 
-func (s *Service) chooseCandidateAndCreateNewPair(ctx context.Context, userId uint64) (*models.ProfileShowcase, error) {
-	candidate, err := s.chooseCandidate(ctx, userId)
+func (s *Service) chooseCandidateAndCreateNewPair(ctx context.Context, me models.Profile) (*models.ProfileShowcase, error) {
+	candidate, err := s.chooseCandidate(ctx, me.UserID)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't choose candidate")
 	}
 	if candidate == 0 {
 		return nil, nil
 	}
-	pa, err := s.repository.CreatePairAttempt(ctx, userId, candidate)
+	pa, err := s.repository.CreatePairAttempt(ctx, me.UserID, candidate)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create pair attempt")
 	}
@@ -151,7 +196,7 @@ func (s *Service) chooseCandidateAndCreateNewPair(ctx context.Context, userId ui
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create pair event")
 	}
-	prof, err := s.createProfileShowcase(ctx, candidate)
+	prof, err := s.createProfileShowcase(ctx, me, candidate)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't create profile showcase")
 	}
@@ -171,19 +216,63 @@ func (s *Service) chooseCandidate(ctx context.Context, userId uint64) (uint64, e
 	if err != nil {
 		return 0, errors.Wrap(err, "can't get profile")
 	}
-	candidates := []uint64{}
+	myTravel, err := s.getTravelMode(ctx, userId)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get travel mode")
+	}
+	myPref = myTravel.SearchFrom(myPref)
+	myProf = myTravel.ShownAt(myProf)
+	hiddenIDs, err := s.repository.GetHiddenUsers(ctx, userId)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get hidden users")
+	}
+	hidden := map[uint64]bool{}
+	for _, id := range hiddenIDs {
+		hidden[id] = true
+	}
+	boostedIDs, err := s.repository.GetBoostedUsers(ctx, time.Now())
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get boosted users")
+	}
+	boosted := map[uint64]bool{}
+	for _, id := range boostedIDs {
+		boosted[id] = true
+	}
+	photoCounts, err := s.repository.GetPhotoCounts(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get photo counts")
+	}
+	pool := []uint64{}
 	for _, id := range ids {
-		if id == userId {
-			continue
+		if id != userId && !hidden[id] {
+			pool = append(pool, id)
 		}
-		pref, err := s.repository.GetPreferences(ctx, id)
-		if err != nil {
-			return 0, errors.Wrap(err, "can't get preferences")
-		}
-		prof, err := s.repository.GetProfile(ctx, id)
-		if err != nil {
-			return 0, errors.Wrap(err, "can't get profile")
+	}
+	if len(pool) == 0 {
+		return 0, nil
+	}
+	profiles, err := s.repository.GetProfiles(ctx, pool)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get profiles")
+	}
+	prefs, err := s.repository.GetPreferencesByUsers(ctx, pool)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get preferences")
+	}
+	travels, err := s.getTravelModes(ctx, pool)
+	if err != nil {
+		return 0, errors.Wrap(err, "can't get travel modes")
+	}
+	candidates := []uint64{}
+	relaxed := []uint64{}
+	score := map[uint64]int{}
+	for _, id := range pool {
+		if _, ok := profiles[id]; !ok {
+			continue
 		}
+		travel := travels[id]
+		pref := travel.SearchFrom(prefs[id])
+		prof := travel.ShownAt(profiles[id])
 		if !myPref.ProfileMatches(prof) || !pref.ProfileMatches(myProf) {
 			continue
 		}
@@ -191,7 +280,19 @@ func (s *Service) chooseCandidate(ctx context.Context, userId uint64) (uint64, e
 		if pa.ID != 0 {
 			continue
 		}
-		candidates = append(candidates, id)
+		completeness := models.NewCompleteness(prof, pref, photoCounts[id])
+		score[id] = myPref.SoftScore(prof)*models.CompletenessLevels + completeness.Level()
+		if boosted[id] {
+			score[id] += boostBonus
+		}
+		if myPref.SoftMatches(prof) {
+			candidates = append(candidates, id)
+		} else {
+			relaxed = append(relaxed, id)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = relaxed
 	}
 	noShows := []uint64{}
 	withLike := []uint64{}
@@ -211,19 +312,39 @@ func (s *Service) chooseCandidate(ctx context.Context, userId uint64) (uint64, e
 		latestPaTime[getWhoIsNotMe(pa.User1, pa.User2, userId)] = pa.CreatedAt
 	}
 	if len(noShows) > 0 {
-		return noShows[rand.Intn(len(noShows))], nil
+		best := bestScored(noShows, score)
+		return best[rand.Intn(len(best))], nil
 	}
 	if len(withDislike) > 0 {
-		sort.Slice(withDislike, func(i, j int) bool {
-			return latestPaTime[withDislike[i]].Before(latestPaTime[withDislike[j]])
-		})
+		sortByScoreAndTime(withDislike, score, latestPaTime)
 		return withDislike[0], nil
 	}
 	if len(withLike) > 0 {
-		sort.Slice(withLike, func(i, j int) bool {
-			return latestPaTime[withLike[i]].Before(latestPaTime[withLike[j]])
-		})
+		sortByScoreAndTime(withLike, score, latestPaTime)
 		return withLike[0], nil
 	}
 	return 0, nil
 }
+
+func bestScored(ids []uint64, score map[uint64]int) []uint64 {
+	best := []uint64{}
+	for _, id := range ids {
+		if len(best) > 0 && score[id] < score[best[0]] {
+			continue
+		}
+		if len(best) > 0 && score[id] > score[best[0]] {
+			best = best[:0]
+		}
+		best = append(best, id)
+	}
+	return best
+}
+
+func sortByScoreAndTime(ids []uint64, score map[uint64]int, latestPaTime map[uint64]time.Time) {
+	sort.Slice(ids, func(i, j int) bool {
+		if score[ids[i]] != score[ids[j]] {
+			return score[ids[i]] > score[ids[j]]
+		}
+		return latestPaTime[ids[i]].Before(latestPaTime[ids[j]])
+	})
+}
@@ -1,6 +1,9 @@
 package service
 
-import "github.com/mayye4ka/pinder/internal/models"
+import (
+	"github.com/mayye4ka/pinder/internal/models"
+	"go.uber.org/mock/gomock"
+)
 
 var (
 	PAID = uint64(777)
@@ -9,10 +12,11 @@ var (
 func (s *ServiceTestSuite) TestNextPartner_InvalidUser() {
 	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{}, nil)
 	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, userId).Return([]string{}, nil)
 
 	candidate, err := s.service.NextPartner(user1Ctx)
 
-	s.Equal("incomplete profile", err.Error())
+	s.Equal("incomplete profile: missing name, gender, birthdate, location, photo, preferences", err.Error())
 	s.Equal(models.ProfileShowcase{}, candidate)
 }
 
@@ -31,11 +35,15 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsHangingPartner() {
 	s.repoMock.EXPECT().GetLastEvent(user1Ctx, PAID).Return(models.PairEvent{EventType: models.PETypeSentToUser1}, nil)
 
 	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(models.Profile{UserID: user2Id}, nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user2Id).Return(models.User{ID: user2Id}, nil)
 	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{photo1, photo2}, nil)
 
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo1).Return(photo1Link, nil)
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo2).Return(photo2Link, nil)
 
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user2Id).Return(models.TravelMode{}, nil)
+
 	candidate, err := s.service.NextPartner(user1Ctx)
 
 	s.Nil(err)
@@ -43,7 +51,9 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsHangingPartner() {
 		Profile: models.Profile{
 			UserID: user2Id,
 		},
-		Photos: photos,
+		Photos:        photos,
+		Compatibility: models.Compatibility{Score: 20},
+		DistanceKm:    1,
 	}, candidate)
 }
 
@@ -62,11 +72,15 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsWhoLikedMe() {
 	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser2).Return(nil)
 
 	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(models.Profile{UserID: user2Id}, nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user2Id).Return(models.User{ID: user2Id}, nil)
 	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{photo1, photo2}, nil)
 
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo1).Return(photo1Link, nil)
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo2).Return(photo2Link, nil)
 
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user2Id).Return(models.TravelMode{}, nil)
+
 	candidate, err := s.service.NextPartner(user1Ctx)
 
 	s.Nil(err)
@@ -74,7 +88,9 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsWhoLikedMe() {
 		Profile: models.Profile{
 			UserID: user2Id,
 		},
-		Photos: photos,
+		Photos:        photos,
+		Compatibility: models.Compatibility{Score: 20},
+		DistanceKm:    1,
 	}, candidate)
 }
 
@@ -93,15 +109,23 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsNewPair() {
 	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
 
 	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id}, nil)
-	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(models.Profile{
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	partnerProfile := models.Profile{
 		UserID: user2Id,
 		Age:    19,
-	}, nil).Times(2)
-	s.repoMock.EXPECT().GetPreferences(user1Ctx, user2Id).Return(models.Preferences{
-		UserID: user2Id,
-		MinAge: 18,
-		MaxAge: 20,
+	}
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{user2Id: partnerProfile}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Preferences{
+		user2Id: {
+			UserID: user2Id,
+			MinAge: 18,
+			MaxAge: 20,
+		},
 	}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(partnerProfile, nil)
 
 	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
 	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
@@ -109,10 +133,14 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsNewPair() {
 	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
 	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
 
+	s.repoMock.EXPECT().GetUser(user1Ctx, user2Id).Return(models.User{ID: user2Id}, nil)
 	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{photo1, photo2}, nil)
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo1).Return(photo1Link, nil)
 	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo2).Return(photo2Link, nil)
 
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user2Id).Return(models.TravelMode{}, nil)
+
 	candidate, err := s.service.NextPartner(user1Ctx)
 
 	s.Nil(err)
@@ -121,7 +149,9 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsNewPair() {
 			UserID: user2Id,
 			Age:    19,
 		},
-		Photos: photos,
+		Photos:        photos,
+		Compatibility: models.Compatibility{Score: 20},
+		DistanceKm:    1,
 	}, candidate)
 }
 
@@ -137,8 +167,237 @@ func (s *ServiceTestSuite) TestNextPartner_ReturnsValuableAdvice() {
 	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
 
 	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+	s.Equal("lower your expectations to zero", err.Error())
+	s.Equal(models.ProfileShowcase{}, candidate)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_PrefersSoftMatches() {
+	user3Id := uint64(125)
+	myPrefs := models.Preferences{
+		UserID:    userId,
+		Interests: []string{"hiking"},
+	}
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(myPrefs, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id, user3Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	hiker := models.Profile{
+		UserID:    user3Id,
+		Interests: []string{"hiking"},
+	}
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Profile{
+		user2Id: {UserID: user2Id},
+		user3Id: hiker,
+	}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Preferences{
+		user2Id: {UserID: user2Id},
+		user3Id: {UserID: user3Id},
+	}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user3Id).Return(hiker, nil)
+
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().CreatePairAttempt(user1Ctx, userId, user3Id).Return(models.PairAttempt{ID: PAID}, nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user3Id).Return(models.User{ID: user3Id}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user3Id).Return([]string{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user3Id).Return(models.TravelMode{}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(user3Id, candidate.Profile.UserID)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_PrefersBoostedUsers() {
+	user3Id := uint64(125)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{UserID: userId}, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id, user3Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{user3Id}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Profile{
+		user2Id: {UserID: user2Id},
+		user3Id: {UserID: user3Id},
+	}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Preferences{
+		user2Id: {UserID: user2Id},
+		user3Id: {UserID: user3Id},
+	}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user3Id).Return(models.Profile{UserID: user3Id}, nil)
+
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().CreatePairAttempt(user1Ctx, userId, user3Id).Return(models.PairAttempt{ID: PAID}, nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user3Id).Return(models.User{ID: user3Id}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user3Id).Return([]string{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user3Id).Return(models.TravelMode{}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(user3Id, candidate.Profile.UserID)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_PrefersCompleteProfiles() {
+	user3Id := uint64(125)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{UserID: userId}, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id, user3Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{user2Id: 1, user3Id: 3}, nil)
+	complete := models.Profile{
+		UserID:    user3Id,
+		Name:      userName,
+		Gender:    models.GenderFemale,
+		Bio:       "bio",
+		Interests: []string{"hiking"},
+	}
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Profile{
+		user2Id: {UserID: user2Id},
+		user3Id: complete,
+	}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.Preferences{
+		user2Id: {UserID: user2Id},
+		user3Id: {UserID: user3Id},
+	}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id, user3Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user3Id).Return(complete, nil)
+
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user3Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().CreatePairAttempt(user1Ctx, userId, user3Id).Return(models.PairAttempt{ID: PAID}, nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user3Id).Return(models.User{ID: user3Id}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user3Id).Return([]string{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user3Id).Return(models.TravelMode{}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(user3Id, candidate.Profile.UserID)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_RelaxesSoftCriteria() {
+	myPrefs := models.Preferences{
+		UserID:    userId,
+		Interests: []string{"hiking"},
+	}
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(myPrefs, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{user2Id: {UserID: user2Id}}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Preferences{user2Id: {UserID: user2Id}}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(models.Profile{UserID: user2Id}, nil)
+
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().CreatePairAttempt(user1Ctx, userId, user2Id).Return(models.PairAttempt{ID: PAID}, nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user2Id).Return(models.User{ID: user2Id}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user2Id).Return(models.TravelMode{}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(user2Id, candidate.Profile.UserID)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_DealbreakerFilters() {
+	myPrefs := models.Preferences{
+		UserID:       userId,
+		Interests:    []string{"hiking"},
+		Dealbreakers: []models.PreferenceCriterion{models.CriterionInterests},
+	}
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(myPrefs, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{user2Id: {UserID: user2Id}}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Preferences{user2Id: {UserID: user2Id}}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.TravelMode{}, nil)
+
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
 
 	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Equal("lower your expectations to zero", err.Error())
+	s.Equal(models.ProfileShowcase{}, candidate)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_SkipsIncognitoUsers() {
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(models.Profile{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{UserID: userId}, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(models.TravelMode{}, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{user2Id}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
 	s.Equal("lower your expectations to zero", err.Error())
 	s.Equal(models.ProfileShowcase{}, candidate)
 }
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const editWindow = 15 * time.Minute
+
+var (
+	errNotMessageSender = &errs.CodableError{
+		Code:    errs.CodePermissionDenied,
+		Message: "only the sender can change this message",
+		Reason:  errs.ReasonNotMessageSender,
+	}
+	errMessageDeleted = &errs.CodableError{
+		Code:    errs.CodeFailedPrecondition,
+		Message: "message is deleted",
+		Reason:  errs.ReasonMessageDeleted,
+	}
+	errEditWindowExpired = &errs.CodableError{
+		Code:    errs.CodeFailedPrecondition,
+		Message: "message can no longer be edited",
+		Reason:  errs.ReasonEditWindowExpired,
+	}
+)
+
+func (s *Service) getChatMessage(ctx context.Context, userId, messageId uint64) (models.Chat, models.Message, error) {
+	msg, err := s.repository.GetMessage(ctx, messageId)
+	if err != nil {
+		return models.Chat{}, models.Message{}, errors.Wrap(err, "can't get message")
+	}
+	chat, err := s.repository.GetChat(ctx, msg.ChatID)
+	if err != nil {
+		return models.Chat{}, models.Message{}, errors.Wrap(err, "can't get chat")
+	}
+	if chat.User1 != userId && chat.User2 != userId {
+		return models.Chat{}, models.Message{}, errPermissionDenied
+	}
+	return chat, msg, nil
+}
+
+func (s *Service) EditMessage(ctx context.Context, messageId uint64, text string) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	v := errs.NewValidator("bad edit")
+	v.Check(text != "", "payload", "empty payload")
+	v.Check(len(text) <= maxTextLen, "payload", "text too long")
+	if err := v.Err(); err != nil {
+		return err
+	}
+	chat, msg, err := s.getChatMessage(ctx, userId, messageId)
+	if err != nil {
+		return err
+	}
+	if msg.SenderID != userId {
+		return errNotMessageSender
+	}
+	if msg.ContentType != models.ContentText {
+		return errs.InvalidField("bad edit", "content_type", "only text messages can be edited")
+	}
+	now := time.Now()
+	if now.Sub(msg.CreatedAt) > editWindow {
+		return errEditWindowExpired
+	}
+	// the repository refuses the edit if the message is deleted by then
+	err = s.repository.EditMessage(ctx, messageId, text, now)
+	if err != nil {
+		return errors.Wrap(err, "can't edit message")
+	}
+	return s.notifyMessageChanged(ctx, []uint64{chat.User1, chat.User2}, models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: messageId,
+		Kind:      models.MessageEdited,
+		Payload:   text,
+	})
+}
+
+func (s *Service) DeleteMessage(ctx context.Context, messageId uint64, forEveryone bool) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	chat, msg, err := s.getChatMessage(ctx, userId, messageId)
+	if err != nil {
+		return err
+	}
+	change := models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: messageId,
+		Kind:      models.MessageDeleted,
+	}
+	if !forEveryone {
+		err = s.repository.HideMessage(ctx, messageId, userId)
+		if err != nil {
+			return errors.Wrap(err, "can't hide message")
+		}
+		return s.notifyMessageChanged(ctx, []uint64{userId}, change)
+	}
+	if msg.SenderID != userId {
+		return errNotMessageSender
+	}
+	if !msg.DeletedAt.IsZero() {
+		return nil
+	}
+	err = s.repository.DeleteMessage(ctx, messageId, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "can't delete message")
+	}
+	// The delete is committed, so a storage failure must not fail the call:
+	// a retry would find the message already deleted and do nothing. The
+	// file storage logs the failure and the orphaned object is only wasted
+	// space.
+	_ = s.delMessageMedia(ctx, msg.ContentType, msg.Payload)
+	return s.notifyMessageChanged(ctx, []uint64{chat.User1, chat.User2}, change)
+}
+
+func (s *Service) GetMessageEdits(ctx context.Context, messageId uint64) ([]models.MessageEdit, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return nil, errUnauthenticated
+	}
+	_, msg, err := s.getChatMessage(ctx, userId, messageId)
+	if err != nil {
+		return nil, err
+	}
+	if !msg.DeletedAt.IsZero() {
+		return nil, errMessageDeleted
+	}
+	edits, err := s.repository.GetMessageEdits(ctx, messageId)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get message edits")
+	}
+	return edits, nil
+}
+
+func (s *Service) notifyMessageChanged(ctx context.Context, receivers []uint64, change models.MessageChange) error {
+	for _, recv := range receivers {
+		err := s.userNotifier.NotifyMessageChanged(ctx, recv, change)
+		if err != nil {
+			return errors.Wrap(err, "can't notify message change")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *ServiceTestSuite) TestEditMessage() {
+	msg := msgText
+	msg.CreatedAt = time.Now().Add(-time.Minute)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().EditMessage(user1Ctx, msg.ID, "fixed", gomock.Any()).Return(nil)
+	change := models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: msg.ID,
+		Kind:      models.MessageEdited,
+		Payload:   "fixed",
+	}
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, userId, change).Return(nil)
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, user2Id, change).Return(nil)
+
+	err := s.service.EditMessage(user1Ctx, msg.ID, "fixed")
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestEditMessage_DeletedMeanwhile() {
+	msg := msgText
+	msg.CreatedAt = time.Now().Add(-time.Minute)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().EditMessage(user1Ctx, msg.ID, "fixed", gomock.Any()).Return(errMessageDeleted)
+
+	err := s.service.EditMessage(user1Ctx, msg.ID, "fixed")
+
+	s.Equal(errs.ReasonMessageDeleted, errs.ReasonOf(err))
+}
+
+func (s *ServiceTestSuite) TestEditMessage_WindowExpired() {
+	msg := msgText
+	msg.CreatedAt = time.Now().Add(-editWindow - time.Minute)
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+
+	err := s.service.EditMessage(user1Ctx, msg.ID, "fixed")
+
+	s.Equal(errEditWindowExpired, err)
+}
+
+func (s *ServiceTestSuite) TestEditMessage_NotText() {
+	msg := msgPhoto
+	msg.CreatedAt = time.Now()
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+
+	err := s.service.EditMessage(user1Ctx, msg.ID, "fixed")
+
+	s.Equal("bad edit: only text messages can be edited", err.Error())
+}
+
+func (s *ServiceTestSuite) TestEditMessage_NotSender() {
+	msg := msgText
+	msg.SenderID = user2Id
+	msg.CreatedAt = time.Now()
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+
+	err := s.service.EditMessage(user1Ctx, msg.ID, "fixed")
+
+	s.Equal(errNotMessageSender, err)
+}
+
+func (s *ServiceTestSuite) TestDeleteMessage_ForMe() {
+	msg := msgPhoto
+	msg.SenderID = user2Id
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().HideMessage(user1Ctx, msg.ID, userId).Return(nil)
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, userId, models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: msg.ID,
+		Kind:      models.MessageDeleted,
+	}).Return(nil)
+
+	err := s.service.DeleteMessage(user1Ctx, msg.ID, false)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestDeleteMessage_ForEveryoneRemovesMedia() {
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgVoice.ID).Return(msgVoice, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	gomock.InOrder(
+		s.repoMock.EXPECT().DeleteMessage(user1Ctx, msgVoice.ID, gomock.Any()).Return(nil),
+		s.fsMock.EXPECT().DelChatVoice(user1Ctx, voice).Return(nil),
+	)
+	change := models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: msgVoice.ID,
+		Kind:      models.MessageDeleted,
+	}
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, userId, change).Return(nil)
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, user2Id, change).Return(nil)
+
+	err := s.service.DeleteMessage(user1Ctx, msgVoice.ID, true)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestDeleteMessage_MediaFailureStillNotifies() {
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgVoice.ID).Return(msgVoice, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().DeleteMessage(user1Ctx, msgVoice.ID, gomock.Any()).Return(nil)
+	s.fsMock.EXPECT().DelChatVoice(user1Ctx, voice).Return(&errs.CodableError{
+		Code:    errs.CodeUnavailable,
+		Message: "can't remove obj",
+	})
+	change := models.MessageChange{
+		ChatID:    chat.ID,
+		MessageID: msgVoice.ID,
+		Kind:      models.MessageDeleted,
+	}
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, userId, change).Return(nil)
+	s.userNotifierMock.EXPECT().NotifyMessageChanged(user1Ctx, user2Id, change).Return(nil)
+
+	err := s.service.DeleteMessage(user1Ctx, msgVoice.ID, true)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestDeleteMessage_ForEveryoneNotSender() {
+	msg := msgText
+	msg.SenderID = user2Id
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msg.ID).Return(msg, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+
+	err := s.service.DeleteMessage(user1Ctx, msg.ID, true)
+
+	s.Equal(errNotMessageSender, err)
+}
+
+func (s *ServiceTestSuite) TestGetMessageEdits() {
+	edits := []models.MessageEdit{{MessageID: msgText.ID, Payload: "typo"}}
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().GetMessageEdits(user1Ctx, msgText.ID).Return(edits, nil)
+
+	res, err := s.service.GetMessageEdits(user1Ctx, msgText.ID)
+
+	s.Nil(err)
+	s.Equal(edits, res)
+}
@@ -3,33 +3,185 @@ package service
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
+	"unicode/utf8"
 
+	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
 	"github.com/pkg/errors"
 )
 
+const (
+	minHeightCm  = 100
+	maxHeightCm  = 250
+	maxInterests = 10
+	maxLanguages = 10
+
+	maxCustomGenderLen = 40
+	maxLocationNameLen = 40
+	maxAge             = 120
+)
+
+var languageCodeRe = regexp.MustCompile(`^[a-z]{2,3}$`)
+
 func (s *Service) UpdProfile(ctx context.Context, newProfile models.Profile) error {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
 		return errUnauthenticated
 	}
 	newProfile.UserID = userId
-	if newProfile.Gender != models.GenderFemale && newProfile.Gender != models.GenderMale {
-		return fmt.Errorf("bad profile")
+	if newProfile.Gender != models.GenderCustom {
+		newProfile.CustomGender = ""
 	}
-	if newProfile.LocationName == "" || newProfile.Name == "" {
-		return fmt.Errorf("bad profile")
+	v := errs.NewValidator("bad profile")
+	v.Check(newProfile.Gender.Valid(), "gender", "invalid gender")
+	if newProfile.Gender == models.GenderCustom {
+		v.Check(newProfile.CustomGender != "", "custom_gender", "custom gender required")
 	}
-	if newProfile.Age == 0 || newProfile.LocationLat == 0 || newProfile.LocationLon == 0 {
-		return fmt.Errorf("bad profile")
+	v.Check(utf8.RuneCountInString(newProfile.CustomGender) <= maxCustomGenderLen, "custom_gender", "custom gender too long")
+	v.Check(newProfile.Name != "", "name", "missing name")
+	v.Check(!newProfile.Birthdate.IsZero(), "birthdate", "missing birthdate")
+	v.Check(newProfile.LocationName != "" && newProfile.LocationLat != 0 && newProfile.LocationLon != 0,
+		"location", "missing location")
+	v.Check(utf8.RuneCountInString(newProfile.LocationName) <= maxLocationNameLen, "location_name", "location name too long")
+	if !newProfile.Birthdate.IsZero() {
+		age := newProfile.AgeAt(time.Now())
+		v.Check(age >= models.MinAge, "birthdate", fmt.Sprintf("must be at least %d years old", models.MinAge))
+		v.Check(age <= maxAge, "birthdate", "invalid birthdate")
 	}
-	err := s.repository.PutProfile(ctx, newProfile)
+	err := s.validateProfileDetails(ctx, v, newProfile)
+	if err != nil {
+		return err
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+	err = s.repository.PutProfile(ctx, newProfile)
 	if err != nil {
 		return errors.Wrap(err, "can't update profile")
 	}
+	s.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+	return nil
+}
+
+func (s *Service) UpdProfileDetails(ctx context.Context, details models.ProfileDetails) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	profile, err := s.repository.GetProfile(ctx, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't get profile")
+	}
+	profile.HeightCm = details.HeightCm
+	profile.Interests = details.Interests
+	profile.Languages = details.Languages
+	profile.RelationshipGoal = details.RelationshipGoal
+	profile.Smoking = details.Smoking
+	profile.Drinking = details.Drinking
+	profile.Children = details.Children
+	return s.UpdProfile(ctx, profile)
+}
+
+func (s *Service) SetBirthdate(ctx context.Context, birthdate time.Time) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	profile, err := s.repository.GetProfile(ctx, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't get profile")
+	}
+	profile.Birthdate = birthdate
+	return s.UpdProfile(ctx, profile)
+}
+
+func (s *Service) validateProfileDetails(ctx context.Context, v *errs.Validator, profile models.Profile) error {
+	v.Check(profile.HeightCm == 0 || validHeight(profile.HeightCm), "height_cm", "height out of range")
+	v.Check(profile.RelationshipGoal == "" || profile.RelationshipGoal.Valid(), "relationship_goal", "unknown relationship goal")
+	v.Check(profile.Smoking == "" || profile.Smoking.Valid(), "smoking", "unknown habit")
+	v.Check(profile.Drinking == "" || profile.Drinking.Valid(), "drinking", "unknown habit")
+	v.Check(profile.Children == "" || profile.Children.Valid(), "children", "unknown children status")
+	validateLanguages(v, profile.Languages)
+	return s.validateInterests(ctx, v, profile.Interests)
+}
+
+func (s *Service) validatePreferenceDetails(ctx context.Context, v *errs.Validator, prefs models.Preferences) error {
+	v.Check(prefs.MinHeightCm == 0 || validHeight(prefs.MinHeightCm), "min_height_cm", "height out of range")
+	v.Check(prefs.MaxHeightCm == 0 || validHeight(prefs.MaxHeightCm), "max_height_cm", "height out of range")
+	v.Check(prefs.MinHeightCm == 0 || prefs.MaxHeightCm == 0 || prefs.MinHeightCm <= prefs.MaxHeightCm,
+		"max_height_cm", "invalid height range")
+	for _, goal := range prefs.RelationshipGoals {
+		v.Check(goal.Valid(), "relationship_goals", "unknown relationship goal")
+	}
+	for _, habit := range prefs.Smoking {
+		v.Check(habit.Valid(), "smoking", "unknown habit")
+	}
+	for _, habit := range prefs.Drinking {
+		v.Check(habit.Valid(), "drinking", "unknown habit")
+	}
+	for _, children := range prefs.Children {
+		v.Check(children.Valid(), "children", "unknown children status")
+	}
+	seenGenders := map[models.Gender]bool{}
+	for _, gender := range prefs.Genders {
+		v.Check(gender.Valid() && !seenGenders[gender], "genders", "invalid gender "+string(gender))
+		seenGenders[gender] = true
+	}
+	seen := map[models.PreferenceCriterion]bool{}
+	for _, criterion := range prefs.Dealbreakers {
+		v.Check(criterion.Valid() && !seen[criterion], "dealbreakers", "invalid dealbreaker "+string(criterion))
+		seen[criterion] = true
+	}
+	validateLanguages(v, prefs.Languages)
+	return s.validateInterests(ctx, v, prefs.Interests)
+}
+
+func (s *Service) validateInterests(ctx context.Context, v *errs.Validator, interests []string) error {
+	if len(interests) == 0 {
+		return nil
+	}
+	if len(interests) > maxInterests {
+		v.Add("interests", "too many interests")
+		return nil
+	}
+	tags, err := s.repository.GetInterestTags(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't get interest tags")
+	}
+	known := map[string]bool{}
+	for _, tag := range tags {
+		known[tag] = true
+	}
+	seen := map[string]bool{}
+	for _, tag := range interests {
+		v.Check(known[tag] && !seen[tag], "interests", "invalid interest "+tag)
+		seen[tag] = true
+	}
 	return nil
 }
 
+func validateLanguages(v *errs.Validator, languages []string) {
+	if len(languages) > maxLanguages {
+		v.Add("languages", "too many languages")
+		return
+	}
+	seen := map[string]bool{}
+	for _, lang := range languages {
+		v.Check(languageCodeRe.MatchString(lang) && !seen[lang], "languages", "invalid language "+lang)
+		seen[lang] = true
+	}
+}
+
+func validHeight(heightCm int) bool {
+	return heightCm >= minHeightCm && heightCm <= maxHeightCm
+}
+
 func (s *Service) GetProfile(ctx context.Context) (models.ProfileShowcase, error) {
 	userId := ctx.Value(userIdContextKey).(uint64)
 	if userId == 0 {
@@ -55,10 +207,38 @@ func (s *Service) UpdPreferences(ctx context.Context, newPreferences models.Pref
 		return errUnauthenticated
 	}
 	newPreferences.UserID = userId
-	err := s.repository.PutPreferences(ctx, newPreferences)
+	v := errs.NewValidator("bad preferences")
+	v.Check(newPreferences.MinAge == 0 || newPreferences.MinAge >= models.MinAge, "min_age", "min age too low")
+	v.Check(newPreferences.MaxAge == 0 || newPreferences.MinAge <= newPreferences.MaxAge, "max_age", "invalid age range")
+	v.Check(newPreferences.LocationRadiusKm >= 0, "location_radius_km", "negative radius")
+	err := s.validatePreferenceDetails(ctx, v, newPreferences)
+	if err != nil {
+		return err
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+	err = s.repository.PutPreferences(ctx, newPreferences)
 	if err != nil {
 		return errors.Wrap(err, "can't update preferences")
 	}
+	s.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdatePreferences,
+		TargetID: userId,
+	})
+	return nil
+}
+
+func (s *Service) SetIncognito(ctx context.Context, incognito bool) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	err := s.repository.SetUserIncognito(ctx, userId, incognito)
+	if err != nil {
+		return errors.Wrap(err, "can't set incognito")
+	}
 	return nil
 }
 
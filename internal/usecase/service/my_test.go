@@ -0,0 +1,303 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+var (
+	profile = models.Profile{
+		UserID:       userId,
+		Name:         userName,
+		Gender:       models.GenderMale,
+		Birthdate:    time.Date(1999, time.March, 15, 0, 0, 0, 0, time.UTC),
+		LocationLat:  55.75,
+		LocationLon:  37.61,
+		LocationName: "Moscow",
+	}
+)
+
+func (s *ServiceTestSuite) TestUpdProfile() {
+	s.repoMock.EXPECT().PutProfile(user1Ctx, profile).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfile(user1Ctx, profile)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdPreferences() {
+	prefs := models.Preferences{UserID: userId, MinAge: 18, MaxAge: 30}
+	s.repoMock.EXPECT().PutPreferences(user1Ctx, prefs).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdatePreferences,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdPreferences(user1Ctx, prefs)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_ExtendedFields() {
+	extended := profile
+	extended.HeightCm = 180
+	extended.Interests = []string{"hiking", "music"}
+	extended.Languages = []string{"en", "ru"}
+	extended.RelationshipGoal = models.RelationshipGoalLongTerm
+	extended.Smoking = models.HabitNever
+	extended.Drinking = models.HabitSometimes
+	extended.Children = models.ChildrenWant
+	s.repoMock.EXPECT().GetInterestTags(user1Ctx).Return([]string{"hiking", "music", "travel"}, nil)
+	s.repoMock.EXPECT().PutProfile(user1Ctx, extended).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfile(user1Ctx, extended)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfileDetails() {
+	extended := profile
+	extended.HeightCm = 180
+	extended.Interests = []string{"hiking"}
+	extended.Languages = []string{"en"}
+	extended.RelationshipGoal = models.RelationshipGoalLongTerm
+	extended.Smoking = models.HabitNever
+	extended.Drinking = models.HabitSometimes
+	extended.Children = models.ChildrenWant
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+	s.repoMock.EXPECT().GetInterestTags(user1Ctx).Return([]string{"hiking", "music"}, nil)
+	s.repoMock.EXPECT().PutProfile(user1Ctx, extended).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfileDetails(user1Ctx, models.ProfileDetails{
+		HeightCm:         180,
+		Interests:        []string{"hiking"},
+		Languages:        []string{"en"},
+		RelationshipGoal: models.RelationshipGoalLongTerm,
+		Smoking:          models.HabitNever,
+		Drinking:         models.HabitSometimes,
+		Children:         models.ChildrenWant,
+	})
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfileDetails_Invalid() {
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+
+	err := s.service.UpdProfileDetails(user1Ctx, models.ProfileDetails{HeightCm: 20})
+
+	s.Equal(errs.CodeInvalidInput, errs.CodeOf(err))
+}
+
+func (s *ServiceTestSuite) TestSetBirthdate() {
+	updated := profile
+	updated.Birthdate = time.Date(2000, time.February, 29, 0, 0, 0, 0, time.UTC)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+	s.repoMock.EXPECT().PutProfile(user1Ctx, updated).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.SetBirthdate(user1Ctx, updated.Birthdate)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestSetBirthdate_TooYoung() {
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+
+	err := s.service.SetBirthdate(user1Ctx, time.Now().AddDate(-17, 0, 0))
+
+	s.Equal("bad profile: must be at least 18 years old", err.Error())
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_UnknownInterest() {
+	extended := profile
+	extended.Interests = []string{"hiking", "knitting"}
+	s.repoMock.EXPECT().GetInterestTags(user1Ctx).Return([]string{"hiking", "music"}, nil)
+
+	err := s.service.UpdProfile(user1Ctx, extended)
+
+	s.Equal("bad profile: invalid interest knitting", err.Error())
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_InvalidDetails() {
+	for _, tc := range []struct {
+		modify func(p *models.Profile)
+		err    string
+	}{
+		{func(p *models.Profile) { p.HeightCm = 20 }, "bad profile: height out of range"},
+		{func(p *models.Profile) { p.RelationshipGoal = "marriage" }, "bad profile: unknown relationship goal"},
+		{func(p *models.Profile) { p.Drinking = "daily" }, "bad profile: unknown habit"},
+		{func(p *models.Profile) { p.Children = "many" }, "bad profile: unknown children status"},
+		{func(p *models.Profile) { p.Languages = []string{"en", "en"} }, "bad profile: invalid language en"},
+		{func(p *models.Profile) { p.Languages = []string{"English"} }, "bad profile: invalid language English"},
+		{func(p *models.Profile) { p.Gender = "robot" }, "bad profile: invalid gender"},
+		{func(p *models.Profile) { p.Birthdate = time.Time{} }, "bad profile: missing birthdate"},
+		{func(p *models.Profile) { p.Name = ""; p.LocationName = "" }, "bad profile: missing name; missing location"},
+		{func(p *models.Profile) { p.Birthdate = time.Now().AddDate(-17, 0, 0) }, "bad profile: must be at least 18 years old"},
+		{func(p *models.Profile) { p.Birthdate = time.Date(1890, time.May, 1, 0, 0, 0, 0, time.UTC) }, "bad profile: invalid birthdate"},
+		{func(p *models.Profile) { p.Gender = models.GenderCustom }, "bad profile: custom gender required"},
+	} {
+		invalid := profile
+		tc.modify(&invalid)
+
+		err := s.service.UpdProfile(user1Ctx, invalid)
+
+		s.Equal(tc.err, err.Error())
+	}
+}
+
+func (s *ServiceTestSuite) TestUpdPreferences_ExtendedFields() {
+	prefs := models.Preferences{
+		UserID:            userId,
+		MinHeightCm:       160,
+		MaxHeightCm:       190,
+		Interests:         []string{"music"},
+		Languages:         []string{"en"},
+		RelationshipGoals: []models.RelationshipGoal{models.RelationshipGoalLongTerm},
+		Smoking:           []models.Habit{models.HabitNever},
+		Dealbreakers:      []models.PreferenceCriterion{models.CriterionSmoking},
+	}
+	s.repoMock.EXPECT().GetInterestTags(user1Ctx).Return([]string{"hiking", "music"}, nil)
+	s.repoMock.EXPECT().PutPreferences(user1Ctx, prefs).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdatePreferences,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdPreferences(user1Ctx, prefs)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdPreferences_InvalidDetails() {
+	for _, tc := range []struct {
+		prefs models.Preferences
+		err   string
+	}{
+		{models.Preferences{MinHeightCm: 190, MaxHeightCm: 160}, "bad preferences: invalid height range"},
+		{models.Preferences{Drinking: []models.Habit{"daily"}}, "bad preferences: unknown habit"},
+		{models.Preferences{Dealbreakers: []models.PreferenceCriterion{"zodiac"}}, "bad preferences: invalid dealbreaker zodiac"},
+		{models.Preferences{Genders: []models.Gender{models.GenderFemale, "robot"}}, "bad preferences: invalid gender robot"},
+	} {
+		err := s.service.UpdPreferences(user1Ctx, tc.prefs)
+
+		s.Equal(tc.err, err.Error())
+	}
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_CustomGender() {
+	custom := profile
+	custom.Gender = models.GenderCustom
+	custom.CustomGender = "genderfluid"
+	s.repoMock.EXPECT().PutProfile(user1Ctx, custom).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfile(user1Ctx, custom)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_CustomGenderCountsRunes() {
+	custom := profile
+	custom.Gender = models.GenderCustom
+	custom.CustomGender = strings.Repeat("я", maxCustomGenderLen)
+	s.repoMock.EXPECT().PutProfile(user1Ctx, custom).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfile(user1Ctx, custom)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_DropsCustomGenderLabel() {
+	labeled := profile
+	labeled.Gender = models.GenderNonBinary
+	labeled.CustomGender = "leftover"
+	expected := labeled
+	expected.CustomGender = ""
+	s.repoMock.EXPECT().PutProfile(user1Ctx, expected).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdateProfile,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdProfile(user1Ctx, labeled)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdPreferences_MultipleGenders() {
+	prefs := models.Preferences{
+		UserID:  userId,
+		Genders: []models.Gender{models.GenderFemale, models.GenderNonBinary},
+	}
+	s.repoMock.EXPECT().PutPreferences(user1Ctx, prefs).Return(nil)
+	s.auditorMock.EXPECT().RecordOrLog(user1Ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionUpdatePreferences,
+		TargetID: userId,
+	})
+
+	err := s.service.UpdPreferences(user1Ctx, prefs)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestSetIncognito() {
+	s.repoMock.EXPECT().SetUserIncognito(user1Ctx, userId, true).Return(nil)
+
+	err := s.service.SetIncognito(user1Ctx, true)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestUpdProfile_AccumulatesViolations() {
+	invalid := profile
+	invalid.Name = ""
+	invalid.HeightCm = 20
+	invalid.Languages = []string{"English"}
+
+	err := s.service.UpdProfile(user1Ctx, invalid)
+
+	var ce *errs.CodableError
+	s.Require().ErrorAs(err, &ce)
+	s.Equal(errs.CodeInvalidInput, ce.Code)
+	s.Equal([]errs.FieldViolation{
+		{Field: "name", Description: "missing name"},
+		{Field: "height_cm", Description: "height out of range"},
+		{Field: "languages", Description: "invalid language English"},
+	}, ce.Violations)
+}
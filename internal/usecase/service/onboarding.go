@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) GetOnboardingStatus(ctx context.Context) (models.Completeness, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return models.Completeness{}, errUnauthenticated
+	}
+	profile, err := s.repository.GetProfile(ctx, userId)
+	if err != nil {
+		return models.Completeness{}, errors.Wrap(err, "can't get profile")
+	}
+	prefs, err := s.repository.GetPreferences(ctx, userId)
+	if err != nil {
+		return models.Completeness{}, errors.Wrap(err, "can't get preferences")
+	}
+	photos, err := s.repository.GetUserPhotos(ctx, userId)
+	if err != nil {
+		return models.Completeness{}, errors.Wrap(err, "can't get user photos")
+	}
+	return models.NewCompleteness(profile, prefs, len(photos)), nil
+}
+
+func joinItems(items []models.CompletenessItem) string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = string(item)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,43 @@
+package service
+
+import "github.com/mayye4ka/pinder/internal/models"
+
+func (s *ServiceTestSuite) TestGetOnboardingStatus() {
+	prof := profile
+	prof.Bio = "bio"
+	prof.HeightCm = 180
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(prof, nil)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{UserID: userId}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, userId).Return([]string{photo1}, nil)
+
+	status, err := s.service.GetOnboardingStatus(user1Ctx)
+
+	s.Nil(err)
+	s.True(status.Complete())
+	s.Equal(models.Completeness{
+		Score: 72,
+		MissingOptional: []models.CompletenessItem{
+			models.CompletenessMorePhotos,
+			models.CompletenessInterests,
+			models.CompletenessLanguages,
+			models.CompletenessRelationshipGoal,
+			models.CompletenessHabits,
+			models.CompletenessChildren,
+		},
+	}, status)
+}
+
+func (s *ServiceTestSuite) TestGetOnboardingStatus_MissingPhotoAndPreferences() {
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(profile, nil)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(models.Preferences{}, nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, userId).Return([]string{}, nil)
+
+	status, err := s.service.GetOnboardingStatus(user1Ctx)
+
+	s.Nil(err)
+	s.False(status.Complete())
+	s.Equal([]models.CompletenessItem{
+		models.CompletenessPhoto,
+		models.CompletenessPreferences,
+	}, status.MissingRequired)
+}
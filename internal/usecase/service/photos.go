@@ -33,11 +33,27 @@ func (s *Service) AddPhoto(ctx context.Context, photo string) error {
 	if userId == 0 {
 		return errUnauthenticated
 	}
+	v := errs.NewValidator("bad photo")
+	v.Check(photo != "", "photo", "empty photo")
+	v.Check(len(photo) <= maxMediaSize, "photo", "photo too large")
+	if err := v.Err(); err != nil {
+		return err
+	}
 	key, err := s.filestorage.SaveProfilePhoto(ctx, []byte(photo))
 	if err != nil {
 		return errors.Wrap(err, "can't save profile photo")
 	}
-	return s.repository.AddPhoto(ctx, userId, key)
+	err = s.repository.AddPhoto(ctx, userId, key)
+	if err != nil {
+		return err
+	}
+	s.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionAddPhoto,
+		TargetID: userId,
+		Details:  "photo: " + key,
+	})
+	return nil
 }
 
 func (s *Service) DeletePhoto(ctx context.Context, photoKey string) error {
@@ -45,11 +61,24 @@ func (s *Service) DeletePhoto(ctx context.Context, photoKey string) error {
 	if userId == 0 {
 		return errUnauthenticated
 	}
+	if photoKey == "" {
+		return errs.InvalidField("bad photo", "photo_key", "missing photo key")
+	}
 	err := s.filestorage.DelProfilePhoto(ctx, photoKey)
 	if err != nil {
 		return errors.Wrap(err, "can't delete profile photo")
 	}
-	return s.repository.DeleteUserPhoto(ctx, userId, photoKey)
+	err = s.repository.DeleteUserPhoto(ctx, userId, photoKey)
+	if err != nil {
+		return err
+	}
+	s.auditor.RecordOrLog(ctx, models.AuditRecord{
+		ActorID:  userId,
+		Action:   models.AuditActionDeletePhoto,
+		TargetID: userId,
+		Details:  "photo: " + photoKey,
+	})
+	return nil
 }
 
 func (s *Service) ReorderPhotos(ctx context.Context, newOrder []string) error {
@@ -71,10 +100,7 @@ func (s *Service) ReorderPhotos(ctx context.Context, newOrder []string) error {
 		exPhotoMap[p] = true
 	}
 	if !reflect.DeepEqual(newPhotoMap, exPhotoMap) {
-		return &errs.CodableError{
-			Code:    errs.CodeInvalidInput,
-			Message: "should specify all photos to reorder",
-		}
+		return errs.InvalidField("bad photo order", "photo_keys", "should specify all photos to reorder")
 	}
 	err = s.repository.ReorderPhotos(ctx, newOrder)
 	if err != nil {
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) React(ctx context.Context, messageId uint64, emoji string) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	if !models.ValidReaction(emoji) {
+		return errs.InvalidField("bad reaction", "emoji", "reaction is not allowed")
+	}
+	chat, msg, err := s.getChatMessage(ctx, userId, messageId)
+	if err != nil {
+		return err
+	}
+	if !msg.DeletedAt.IsZero() {
+		return errMessageDeleted
+	}
+	err = s.repository.PutReaction(ctx, messageId, userId, emoji)
+	if err != nil {
+		return errors.Wrap(err, "can't put reaction")
+	}
+	return s.notifyReaction(ctx, chat, userId, messageId, emoji)
+}
+
+func (s *Service) Unreact(ctx context.Context, messageId uint64) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	chat, _, err := s.getChatMessage(ctx, userId, messageId)
+	if err != nil {
+		return err
+	}
+	err = s.repository.DeleteReaction(ctx, messageId, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't delete reaction")
+	}
+	return s.notifyReaction(ctx, chat, userId, messageId, "")
+}
+
+func (s *Service) notifyReaction(ctx context.Context, chat models.Chat, userId, messageId uint64, emoji string) error {
+	err := s.userNotifier.NotifyReaction(ctx, getWhoIsNotMe(chat.User1, chat.User2, userId), models.ReactionNotification{
+		ChatID:    chat.ID,
+		MessageID: messageId,
+		Emoji:     emoji,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't notify reaction")
+	}
+	return nil
+}
+
+func reactionsToShowcase(reactions []models.Reaction, userId uint64) []models.ReactionShowcase {
+	if len(reactions) == 0 {
+		return nil
+	}
+	res := make([]models.ReactionShowcase, len(reactions))
+	for i, r := range reactions {
+		res[i] = models.ReactionShowcase{
+			Emoji: r.Emoji,
+			ByMe:  r.UserID == userId,
+		}
+	}
+	return res
+}
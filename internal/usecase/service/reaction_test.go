@@ -0,0 +1,49 @@
+package service
+
+import (
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *ServiceTestSuite) TestReact() {
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().PutReaction(user1Ctx, msgText.ID, userId, "🔥").Return(nil)
+	s.userNotifierMock.EXPECT().NotifyReaction(user1Ctx, user2Id, models.ReactionNotification{
+		ChatID:    chat.ID,
+		MessageID: msgText.ID,
+		Emoji:     "🔥",
+	}).Return(nil)
+
+	err := s.service.React(user1Ctx, msgText.ID, "🔥")
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestReact_NotAllowed() {
+	err := s.service.React(user1Ctx, msgText.ID, "🍕")
+
+	s.Equal("bad reaction: reaction is not allowed", err.Error())
+}
+
+func (s *ServiceTestSuite) TestReact_NotParticipant() {
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(models.Chat{ID: chat.ID, User1: 200, User2: 201}, nil)
+
+	err := s.service.React(user1Ctx, msgText.ID, "👍")
+
+	s.Equal(errPermissionDenied, err)
+}
+
+func (s *ServiceTestSuite) TestUnreact() {
+	s.repoMock.EXPECT().GetMessage(user1Ctx, msgText.ID).Return(msgText, nil)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().DeleteReaction(user1Ctx, msgText.ID, userId).Return(nil)
+	s.userNotifierMock.EXPECT().NotifyReaction(user1Ctx, user2Id, models.ReactionNotification{
+		ChatID:    chat.ID,
+		MessageID: msgText.ID,
+	}).Return(nil)
+
+	err := s.service.Unreact(user1Ctx, msgText.ID)
+
+	s.Nil(err)
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+
+	maxSearchQueryLen = 256
+	maxSearchTerms    = 8
+	// minSearchTermLen matches InnoDB's default innodb_ft_min_token_size;
+	// shorter words are not in the fulltext index and a required one
+	// would make every search miss.
+	minSearchTermLen = 3
+
+	snippetRadius = 40
+)
+
+func (s *Service) SearchMessages(ctx context.Context, query string, chatId uint64, page models.MessagePage) (models.SearchResult, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return models.SearchResult{}, errUnauthenticated
+	}
+	if page.Limit == 0 {
+		page.Limit = defaultSearchPageSize
+	}
+	words := queryWords(query)
+	terms := searchTerms(words)
+	v := errs.NewValidator("bad search")
+	v.Check(len(query) <= maxSearchQueryLen, "query", "query too long")
+	v.Check(len(words) > 0, "query", "empty query")
+	v.Check(len(words) == 0 || len(terms) > 0, "query", "words too short or too common")
+	v.Check(len(terms) <= maxSearchTerms, "query", "too many words")
+	v.Check(page.Direction == "" || page.Direction == models.PageOlder, "direction", "only older pages are supported")
+	v.Check(page.Limit > 0 && page.Limit <= maxSearchPageSize, "limit", "limit out of range")
+	if err := v.Err(); err != nil {
+		return models.SearchResult{}, err
+	}
+	if chatId != 0 {
+		chat, err := s.repository.GetChat(ctx, chatId)
+		if err != nil {
+			return models.SearchResult{}, errors.Wrap(err, "can't get chat")
+		}
+		if chat.User1 != userId && chat.User2 != userId {
+			return models.SearchResult{}, errPermissionDenied
+		}
+	}
+	matches, err := s.repository.SearchMessages(ctx, userId, chatId, terms, models.MessagePage{
+		Cursor:    page.Cursor,
+		Direction: models.PageOlder,
+		Limit:     page.Limit + 1,
+	})
+	if err != nil {
+		return models.SearchResult{}, errors.Wrap(err, "can't search messages")
+	}
+	result := models.SearchResult{Hits: []models.SearchHit{}}
+	if len(matches) > page.Limit {
+		matches = matches[:page.Limit]
+		result.NextCursor = matches[len(matches)-1].Message.ID
+	}
+	for _, match := range matches {
+		snippet, highlights := makeSnippet(match.Text, terms)
+		result.Hits = append(result.Hits, models.SearchHit{
+			ChatID:      match.Message.ChatID,
+			MessageID:   match.Message.ID,
+			SentByMe:    match.Message.SenderID == userId,
+			ContentType: match.Message.ContentType,
+			Snippet:     snippet,
+			Highlights:  highlights,
+			CreatedAt:   match.Message.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// innodbStopwords is InnoDB's default fulltext stopword list. These words
+// are never indexed, so they are dropped from the query like short ones.
+var innodbStopwords = []string{
+	"a", "about", "an", "are", "as", "at", "be", "by", "com", "de", "en", "for",
+	"from", "how", "i", "in", "is", "it", "la", "of", "on", "or", "that", "the",
+	"this", "to", "was", "what", "when", "where", "who", "will", "with", "und", "www",
+}
+
+func queryWords(query string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool { return !isWordRune(r) }) {
+		if !slices.Contains(words, word) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func searchTerms(words []string) []string {
+	var terms []string
+	for _, word := range words {
+		if utf8.RuneCountInString(word) < minSearchTermLen || slices.Contains(innodbStopwords, word) {
+			continue
+		}
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+func makeSnippet(text string, terms []string) (string, []models.TextRange) {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	var found []models.TextRange
+	for i := 0; i < len(lower); i++ {
+		if i > 0 && isWordRune(lower[i-1]) {
+			continue
+		}
+		for _, term := range terms {
+			if !hasRunePrefix(lower[i:], []rune(term)) {
+				continue
+			}
+			end := i
+			for end < len(lower) && isWordRune(lower[end]) {
+				end++
+			}
+			found = append(found, models.TextRange{Start: i, End: end})
+			i = end - 1
+			break
+		}
+	}
+	start, end := 0, min(len(runes), 2*snippetRadius)
+	if len(found) > 0 {
+		start = max(0, found[0].Start-snippetRadius)
+		end = min(len(runes), found[0].End+snippetRadius)
+	}
+	snippet := string(runes[start:end])
+	offset := -start
+	if start > 0 {
+		snippet = "…" + snippet
+		offset++
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	var highlights []models.TextRange
+	for _, r := range found {
+		if r.End > end {
+			break
+		}
+		highlights = append(highlights, models.TextRange{Start: r.Start + offset, End: r.End + offset})
+	}
+	return snippet, highlights
+}
+
+func hasRunePrefix(s, prefix []rune) bool {
+	return len(s) >= len(prefix) && slices.Equal(s[:len(prefix)], prefix)
+}
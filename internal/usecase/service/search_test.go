@@ -0,0 +1,109 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *ServiceTestSuite) TestSearchMessages() {
+	text := msgText
+	text.Payload = "see you at the Cinema tonight"
+	s.repoMock.EXPECT().SearchMessages(user1Ctx, userId, uint64(0), []string{"cinema"}, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     defaultSearchPageSize + 1,
+	}).Return([]models.MessageMatch{
+		{Message: msgVoice, Text: "cinematic trailer"},
+		{Message: text, Text: text.Payload},
+	}, nil)
+
+	res, err := s.service.SearchMessages(user1Ctx, "cinema!", 0, models.MessagePage{})
+
+	s.Nil(err)
+	s.Equal(models.SearchResult{
+		Hits: []models.SearchHit{
+			{
+				ChatID:      chat.ID,
+				MessageID:   msgVoice.ID,
+				SentByMe:    true,
+				ContentType: models.ContentVoice,
+				Snippet:     "cinematic trailer",
+				Highlights:  []models.TextRange{{Start: 0, End: 9}},
+			},
+			{
+				ChatID:      chat.ID,
+				MessageID:   text.ID,
+				SentByMe:    true,
+				ContentType: models.ContentText,
+				Snippet:     text.Payload,
+				Highlights:  []models.TextRange{{Start: 15, End: 21}},
+			},
+		},
+	}, res)
+}
+
+func (s *ServiceTestSuite) TestSearchMessages_InChatNextPage() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.repoMock.EXPECT().SearchMessages(user1Ctx, userId, chat.ID, []string{"tea"}, models.MessagePage{
+		Cursor:    10,
+		Direction: models.PageOlder,
+		Limit:     2,
+	}).Return([]models.MessageMatch{
+		{Message: msgText, Text: msgText.Payload},
+		{Message: msgVoice, Text: "tea"},
+	}, nil)
+
+	res, err := s.service.SearchMessages(user1Ctx, "Tea tea", chat.ID, models.MessagePage{Cursor: 10, Limit: 1})
+
+	s.Nil(err)
+	s.Len(res.Hits, 1)
+	s.Equal(msgText.ID, res.NextCursor)
+}
+
+func (s *ServiceTestSuite) TestSearchMessages_NotParticipant() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(models.Chat{ID: chat.ID, User1: 200, User2: 201}, nil)
+
+	_, err := s.service.SearchMessages(user1Ctx, "hello", chat.ID, models.MessagePage{})
+
+	s.Equal(errPermissionDenied, err)
+}
+
+func (s *ServiceTestSuite) TestSearchMessages_EmptyQuery() {
+	_, err := s.service.SearchMessages(user1Ctx, " +-* ", 0, models.MessagePage{})
+
+	s.Equal("bad search: empty query", err.Error())
+}
+
+func (s *ServiceTestSuite) TestSearchMessages_DropsShortAndStopwords() {
+	s.repoMock.EXPECT().SearchMessages(user1Ctx, userId, uint64(0), []string{"cinema"}, models.MessagePage{
+		Direction: models.PageOlder,
+		Limit:     defaultSearchPageSize + 1,
+	}).Return(nil, nil)
+
+	res, err := s.service.SearchMessages(user1Ctx, "to the cinema at 8", 0, models.MessagePage{})
+
+	s.Nil(err)
+	s.Empty(res.Hits)
+}
+
+func (s *ServiceTestSuite) TestSearchMessages_OnlyStopwords() {
+	_, err := s.service.SearchMessages(user1Ctx, "what is it", 0, models.MessagePage{})
+
+	s.Equal("bad search: words too short or too common", err.Error())
+}
+
+func (s *ServiceTestSuite) TestMakeSnippet_Window() {
+	text := strings.Repeat("a ", 50) + "Hello World " + strings.Repeat("b ", 50)
+
+	snippet, highlights := makeSnippet(text, []string{"hello", "wor"})
+
+	s.Equal("…"+strings.Repeat("a ", 20)+"Hello World"+strings.Repeat(" b", 17)+"…", snippet)
+	s.Equal([]models.TextRange{{Start: 41, End: 46}, {Start: 47, End: 52}}, highlights)
+}
+
+func (s *ServiceTestSuite) TestMakeSnippet_NoMatch() {
+	snippet, highlights := makeSnippet("café", []string{"cafe"})
+
+	s.Equal("café", snippet)
+	s.Nil(highlights)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/mayye4ka/pinder/internal/errs"
 	"github.com/mayye4ka/pinder/internal/models"
@@ -11,12 +13,14 @@ const userIdContextKey = "user_id"
 
 var (
 	errUnauthenticated = &errs.CodableError{
-		Code:    errs.CodePermissionDenied,
+		Code:    errs.CodeUnauthenticated,
 		Message: "unauthenticated for this endpoint",
+		Reason:  errs.ReasonUnauthenticated,
 	}
 	errPermissionDenied = &errs.CodableError{
 		Code:    errs.CodePermissionDenied,
 		Message: "no permissions for this action",
+		Reason:  errs.ReasonNotChatMember,
 	}
 )
 
@@ -25,18 +29,40 @@ type Service struct {
 	filestorage  FileStorage
 	userNotifier UserNotifier
 	stt          Stt
+	auditor      Auditor
+
+	typingMu        sync.Mutex
+	typingSent      map[typingKey]time.Time
+	typingExpiredAt time.Time
 }
 
 type Repository interface {
+	GetUser(ctx context.Context, userID uint64) (models.User, error)
+	GetUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.User, error)
 	GetProfile(ctx context.Context, userID uint64) (models.Profile, error)
+	GetProfiles(ctx context.Context, userIDs []uint64) (map[uint64]models.Profile, error)
 	PutProfile(ctx context.Context, newProfile models.Profile) error
+	GetInterestTags(ctx context.Context) ([]string, error)
 	AddPhoto(ctx context.Context, userID uint64, photoKey string) error
 	GetUserPhotos(ctx context.Context, userID uint64) ([]string, error)
+	GetPhotoCounts(ctx context.Context) (map[uint64]int, error)
+	GetFirstPhotos(ctx context.Context, userIDs []uint64) (map[uint64]string, error)
 	DeleteUserPhoto(ctx context.Context, userID uint64, photoKey string) error
 	ReorderPhotos(ctx context.Context, newOrder []string) error
 	GetPreferences(ctx context.Context, userID uint64) (models.Preferences, error)
+	GetPreferencesByUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.Preferences, error)
 	PutPreferences(ctx context.Context, newPreferences models.Preferences) error
 	GetAllValidUsers(ctx context.Context) ([]uint64, error)
+	GetHiddenUsers(ctx context.Context, viewerID uint64) ([]uint64, error)
+	SetUserIncognito(ctx context.Context, userID uint64, incognito bool) error
+	SetUserShowViewers(ctx context.Context, userID uint64, showViewers bool) error
+	GetDailyProfileStats(ctx context.Context, userID uint64, from time.Time) ([]models.DailyProfileStats, error)
+	GetRecentViewers(ctx context.Context, userID uint64, since time.Time, limit int) ([]models.ProfileView, error)
+	GetTravelMode(ctx context.Context, userID uint64) (models.TravelMode, error)
+	GetTravelModes(ctx context.Context, userIDs []uint64) (map[uint64]models.TravelMode, error)
+	PutTravelMode(ctx context.Context, mode models.TravelMode) error
+	DeleteTravelMode(ctx context.Context, userID uint64) error
+	GetBoostedUsers(ctx context.Context, at time.Time) ([]uint64, error)
 
 	GetPendingPairAttempts(ctx context.Context, user1ID uint64) ([]models.PairAttempt, error)
 	GetWhoLikedMe(ctx context.Context, userID uint64) (uint64, error)
@@ -49,11 +75,24 @@ type Repository interface {
 	GetLastEvent(ctx context.Context, PAID uint64) (models.PairEvent, error)
 
 	CreateChat(ctx context.Context, user1, user2 uint64) error
-	GetChats(ctx context.Context, userID uint64) ([]models.Chat, error)
+	GetChatSummaries(ctx context.Context, userID uint64, page models.ChatPage) ([]models.ChatSummary, error)
 	GetChat(ctx context.Context, id uint64) (models.Chat, error)
-	SendMessage(ctx context.Context, chatID, sender uint64, contentType models.MsgContentType, payload string) (models.Message, error)
-	GetMessages(ctx context.Context, chatID uint64) ([]models.Message, error)
+	SendMessage(ctx context.Context, sender uint64, msg models.OutgoingMessage) (models.Message, error)
+	GetMessageByClientID(ctx context.Context, sender uint64, clientMessageID string) (models.Message, bool, error)
+	GetMessages(ctx context.Context, chatID, viewerID uint64, page models.MessagePage) ([]models.Message, error)
 	GetMessage(ctx context.Context, msgID uint64) (models.Message, error)
+	GetMessagesByIDs(ctx context.Context, msgIDs []uint64) (map[uint64]models.Message, error)
+	GetHiddenMessageIDs(ctx context.Context, userID uint64, msgIDs []uint64) (map[uint64]bool, error)
+	SearchMessages(ctx context.Context, userID, chatID uint64, terms []string, page models.MessagePage) ([]models.MessageMatch, error)
+	MarkRead(ctx context.Context, chatID, userID, messageID uint64) (bool, error)
+	GetReadCursors(ctx context.Context, chatID uint64) (map[uint64]uint64, error)
+	EditMessage(ctx context.Context, msgID uint64, payload string, at time.Time) error
+	DeleteMessage(ctx context.Context, msgID uint64, at time.Time) error
+	HideMessage(ctx context.Context, msgID, userID uint64) error
+	GetMessageEdits(ctx context.Context, msgID uint64) ([]models.MessageEdit, error)
+	PutReaction(ctx context.Context, msgID, userID uint64, emoji string) error
+	DeleteReaction(ctx context.Context, msgID, userID uint64) error
+	GetReactions(ctx context.Context, msgIDs []uint64) (map[uint64][]models.Reaction, error)
 
 	GetMessageTranscription(ctx context.Context, id uint64) (string, bool, error)
 	SaveMessageTranscription(ctx context.Context, id uint64, text string) error
@@ -66,10 +105,12 @@ type FileStorage interface {
 
 	MakeChatPhotoLink(ctx context.Context, key string) (string, error)
 	SaveChatPhoto(ctx context.Context, paylaod []byte) (string, error)
+	DelChatPhoto(ctx context.Context, key string) error
 
 	MakeChatVoiceLink(ctx context.Context, key string) (string, error)
 	SaveChatVoice(ctx context.Context, payload []byte) (string, error)
 	GetChatVoice(ctx context.Context, key string) (string, error)
+	DelChatVoice(ctx context.Context, key string) error
 }
 
 type UserNotifier interface {
@@ -77,17 +118,27 @@ type UserNotifier interface {
 	NotifyLiked(ctx context.Context, userId uint64, notification models.LikeNotification) error
 	SendMessage(ctx context.Context, userId uint64, notification models.MessageSend) error
 	SendTranscribedMessage(ctx context.Context, userId uint64, notification models.MessageTranscibed) error
+	NotifyRead(ctx context.Context, userId uint64, notification models.ReadReceipt) error
+	NotifyTyping(ctx context.Context, userId uint64, notification models.TypingNotification) error
+	NotifyMessageChanged(ctx context.Context, userId uint64, notification models.MessageChange) error
+	NotifyReaction(ctx context.Context, userId uint64, notification models.ReactionNotification) error
 }
 
 type Stt interface {
 	PutTask(ctx context.Context, task models.SttTask) error
 }
 
-func New(repo Repository, filestorage FileStorage, userNotifier UserNotifier, stt Stt) *Service {
+type Auditor interface {
+	RecordOrLog(ctx context.Context, record models.AuditRecord)
+}
+
+func New(repo Repository, filestorage FileStorage, userNotifier UserNotifier, stt Stt, auditor Auditor) *Service {
 	return &Service{
 		repository:   repo,
 		filestorage:  filestorage,
 		userNotifier: userNotifier,
 		stt:          stt,
+		auditor:      auditor,
+		typingSent:   map[typingKey]time.Time{},
 	}
 }
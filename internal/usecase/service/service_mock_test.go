@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/mayye4ka/pinder/internal/models"
 	gomock "go.uber.org/mock/gomock"
@@ -97,6 +98,48 @@ func (mr *MockRepositoryMockRecorder) CreatePairAttempt(ctx, user1, user2 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePairAttempt", reflect.TypeOf((*MockRepository)(nil).CreatePairAttempt), ctx, user1, user2)
 }
 
+// DeleteMessage mocks base method.
+func (m *MockRepository) DeleteMessage(ctx context.Context, msgID uint64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, msgID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockRepositoryMockRecorder) DeleteMessage(ctx, msgID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockRepository)(nil).DeleteMessage), ctx, msgID, at)
+}
+
+// DeleteReaction mocks base method.
+func (m *MockRepository) DeleteReaction(ctx context.Context, msgID, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReaction", ctx, msgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReaction indicates an expected call of DeleteReaction.
+func (mr *MockRepositoryMockRecorder) DeleteReaction(ctx, msgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReaction", reflect.TypeOf((*MockRepository)(nil).DeleteReaction), ctx, msgID, userID)
+}
+
+// DeleteTravelMode mocks base method.
+func (m *MockRepository) DeleteTravelMode(ctx context.Context, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTravelMode", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTravelMode indicates an expected call of DeleteTravelMode.
+func (mr *MockRepositoryMockRecorder) DeleteTravelMode(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTravelMode", reflect.TypeOf((*MockRepository)(nil).DeleteTravelMode), ctx, userID)
+}
+
 // DeleteUserPhoto mocks base method.
 func (m *MockRepository) DeleteUserPhoto(ctx context.Context, userID uint64, photoKey string) error {
 	m.ctrl.T.Helper()
@@ -111,6 +154,20 @@ func (mr *MockRepositoryMockRecorder) DeleteUserPhoto(ctx, userID, photoKey any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPhoto", reflect.TypeOf((*MockRepository)(nil).DeleteUserPhoto), ctx, userID, photoKey)
 }
 
+// EditMessage mocks base method.
+func (m *MockRepository) EditMessage(ctx context.Context, msgID uint64, payload string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", ctx, msgID, payload, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockRepositoryMockRecorder) EditMessage(ctx, msgID, payload, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockRepository)(nil).EditMessage), ctx, msgID, payload, at)
+}
+
 // FinishPairAttempt mocks base method.
 func (m *MockRepository) FinishPairAttempt(ctx context.Context, PAID uint64, PAState models.PAState) error {
 	m.ctrl.T.Helper()
@@ -140,6 +197,21 @@ func (mr *MockRepositoryMockRecorder) GetAllValidUsers(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllValidUsers", reflect.TypeOf((*MockRepository)(nil).GetAllValidUsers), ctx)
 }
 
+// GetBoostedUsers mocks base method.
+func (m *MockRepository) GetBoostedUsers(ctx context.Context, at time.Time) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoostedUsers", ctx, at)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoostedUsers indicates an expected call of GetBoostedUsers.
+func (mr *MockRepositoryMockRecorder) GetBoostedUsers(ctx, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoostedUsers", reflect.TypeOf((*MockRepository)(nil).GetBoostedUsers), ctx, at)
+}
+
 // GetChat mocks base method.
 func (m *MockRepository) GetChat(ctx context.Context, id uint64) (models.Chat, error) {
 	m.ctrl.T.Helper()
@@ -155,19 +227,94 @@ func (mr *MockRepositoryMockRecorder) GetChat(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*MockRepository)(nil).GetChat), ctx, id)
 }
 
-// GetChats mocks base method.
-func (m *MockRepository) GetChats(ctx context.Context, userID uint64) ([]models.Chat, error) {
+// GetChatSummaries mocks base method.
+func (m *MockRepository) GetChatSummaries(ctx context.Context, userID uint64, page models.ChatPage) ([]models.ChatSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatSummaries", ctx, userID, page)
+	ret0, _ := ret[0].([]models.ChatSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSummaries indicates an expected call of GetChatSummaries.
+func (mr *MockRepositoryMockRecorder) GetChatSummaries(ctx, userID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSummaries", reflect.TypeOf((*MockRepository)(nil).GetChatSummaries), ctx, userID, page)
+}
+
+// GetDailyProfileStats mocks base method.
+func (m *MockRepository) GetDailyProfileStats(ctx context.Context, userID uint64, from time.Time) ([]models.DailyProfileStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyProfileStats", ctx, userID, from)
+	ret0, _ := ret[0].([]models.DailyProfileStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyProfileStats indicates an expected call of GetDailyProfileStats.
+func (mr *MockRepositoryMockRecorder) GetDailyProfileStats(ctx, userID, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyProfileStats", reflect.TypeOf((*MockRepository)(nil).GetDailyProfileStats), ctx, userID, from)
+}
+
+// GetFirstPhotos mocks base method.
+func (m *MockRepository) GetFirstPhotos(ctx context.Context, userIDs []uint64) (map[uint64]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirstPhotos", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirstPhotos indicates an expected call of GetFirstPhotos.
+func (mr *MockRepositoryMockRecorder) GetFirstPhotos(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirstPhotos", reflect.TypeOf((*MockRepository)(nil).GetFirstPhotos), ctx, userIDs)
+}
+
+// GetHiddenMessageIDs mocks base method.
+func (m *MockRepository) GetHiddenMessageIDs(ctx context.Context, userID uint64, msgIDs []uint64) (map[uint64]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHiddenMessageIDs", ctx, userID, msgIDs)
+	ret0, _ := ret[0].(map[uint64]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHiddenMessageIDs indicates an expected call of GetHiddenMessageIDs.
+func (mr *MockRepositoryMockRecorder) GetHiddenMessageIDs(ctx, userID, msgIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHiddenMessageIDs", reflect.TypeOf((*MockRepository)(nil).GetHiddenMessageIDs), ctx, userID, msgIDs)
+}
+
+// GetHiddenUsers mocks base method.
+func (m *MockRepository) GetHiddenUsers(ctx context.Context, viewerID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHiddenUsers", ctx, viewerID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHiddenUsers indicates an expected call of GetHiddenUsers.
+func (mr *MockRepositoryMockRecorder) GetHiddenUsers(ctx, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHiddenUsers", reflect.TypeOf((*MockRepository)(nil).GetHiddenUsers), ctx, viewerID)
+}
+
+// GetInterestTags mocks base method.
+func (m *MockRepository) GetInterestTags(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChats", ctx, userID)
-	ret0, _ := ret[0].([]models.Chat)
+	ret := m.ctrl.Call(m, "GetInterestTags", ctx)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetChats indicates an expected call of GetChats.
-func (mr *MockRepositoryMockRecorder) GetChats(ctx, userID any) *gomock.Call {
+// GetInterestTags indicates an expected call of GetInterestTags.
+func (mr *MockRepositoryMockRecorder) GetInterestTags(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*MockRepository)(nil).GetChats), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterestTags", reflect.TypeOf((*MockRepository)(nil).GetInterestTags), ctx)
 }
 
 // GetLastEvent mocks base method.
@@ -230,6 +377,37 @@ func (mr *MockRepositoryMockRecorder) GetMessage(ctx, msgID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockRepository)(nil).GetMessage), ctx, msgID)
 }
 
+// GetMessageByClientID mocks base method.
+func (m *MockRepository) GetMessageByClientID(ctx context.Context, sender uint64, clientMessageID string) (models.Message, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByClientID", ctx, sender, clientMessageID)
+	ret0, _ := ret[0].(models.Message)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMessageByClientID indicates an expected call of GetMessageByClientID.
+func (mr *MockRepositoryMockRecorder) GetMessageByClientID(ctx, sender, clientMessageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByClientID", reflect.TypeOf((*MockRepository)(nil).GetMessageByClientID), ctx, sender, clientMessageID)
+}
+
+// GetMessageEdits mocks base method.
+func (m *MockRepository) GetMessageEdits(ctx context.Context, msgID uint64) ([]models.MessageEdit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageEdits", ctx, msgID)
+	ret0, _ := ret[0].([]models.MessageEdit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageEdits indicates an expected call of GetMessageEdits.
+func (mr *MockRepositoryMockRecorder) GetMessageEdits(ctx, msgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageEdits", reflect.TypeOf((*MockRepository)(nil).GetMessageEdits), ctx, msgID)
+}
+
 // GetMessageTranscription mocks base method.
 func (m *MockRepository) GetMessageTranscription(ctx context.Context, id uint64) (string, bool, error) {
 	m.ctrl.T.Helper()
@@ -247,18 +425,33 @@ func (mr *MockRepositoryMockRecorder) GetMessageTranscription(ctx, id any) *gomo
 }
 
 // GetMessages mocks base method.
-func (m *MockRepository) GetMessages(ctx context.Context, chatID uint64) ([]models.Message, error) {
+func (m *MockRepository) GetMessages(ctx context.Context, chatID, viewerID uint64, page models.MessagePage) ([]models.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMessages", ctx, chatID)
+	ret := m.ctrl.Call(m, "GetMessages", ctx, chatID, viewerID, page)
 	ret0, _ := ret[0].([]models.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMessages indicates an expected call of GetMessages.
-func (mr *MockRepositoryMockRecorder) GetMessages(ctx, chatID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) GetMessages(ctx, chatID, viewerID, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockRepository)(nil).GetMessages), ctx, chatID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockRepository)(nil).GetMessages), ctx, chatID, viewerID, page)
+}
+
+// GetMessagesByIDs mocks base method.
+func (m *MockRepository) GetMessagesByIDs(ctx context.Context, msgIDs []uint64) (map[uint64]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByIDs", ctx, msgIDs)
+	ret0, _ := ret[0].(map[uint64]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByIDs indicates an expected call of GetMessagesByIDs.
+func (mr *MockRepositoryMockRecorder) GetMessagesByIDs(ctx, msgIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByIDs", reflect.TypeOf((*MockRepository)(nil).GetMessagesByIDs), ctx, msgIDs)
 }
 
 // GetPendingPairAttemptByUserPair mocks base method.
@@ -291,6 +484,21 @@ func (mr *MockRepositoryMockRecorder) GetPendingPairAttempts(ctx, user1ID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingPairAttempts", reflect.TypeOf((*MockRepository)(nil).GetPendingPairAttempts), ctx, user1ID)
 }
 
+// GetPhotoCounts mocks base method.
+func (m *MockRepository) GetPhotoCounts(ctx context.Context) (map[uint64]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhotoCounts", ctx)
+	ret0, _ := ret[0].(map[uint64]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPhotoCounts indicates an expected call of GetPhotoCounts.
+func (mr *MockRepositoryMockRecorder) GetPhotoCounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhotoCounts", reflect.TypeOf((*MockRepository)(nil).GetPhotoCounts), ctx)
+}
+
 // GetPreferences mocks base method.
 func (m *MockRepository) GetPreferences(ctx context.Context, userID uint64) (models.Preferences, error) {
 	m.ctrl.T.Helper()
@@ -306,6 +514,21 @@ func (mr *MockRepositoryMockRecorder) GetPreferences(ctx, userID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockRepository)(nil).GetPreferences), ctx, userID)
 }
 
+// GetPreferencesByUsers mocks base method.
+func (m *MockRepository) GetPreferencesByUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.Preferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferencesByUsers", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]models.Preferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferencesByUsers indicates an expected call of GetPreferencesByUsers.
+func (mr *MockRepositoryMockRecorder) GetPreferencesByUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferencesByUsers", reflect.TypeOf((*MockRepository)(nil).GetPreferencesByUsers), ctx, userIDs)
+}
+
 // GetProfile mocks base method.
 func (m *MockRepository) GetProfile(ctx context.Context, userID uint64) (models.Profile, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +544,111 @@ func (mr *MockRepositoryMockRecorder) GetProfile(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockRepository)(nil).GetProfile), ctx, userID)
 }
 
+// GetProfiles mocks base method.
+func (m *MockRepository) GetProfiles(ctx context.Context, userIDs []uint64) (map[uint64]models.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfiles", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]models.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfiles indicates an expected call of GetProfiles.
+func (mr *MockRepositoryMockRecorder) GetProfiles(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfiles", reflect.TypeOf((*MockRepository)(nil).GetProfiles), ctx, userIDs)
+}
+
+// GetReactions mocks base method.
+func (m *MockRepository) GetReactions(ctx context.Context, msgIDs []uint64) (map[uint64][]models.Reaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReactions", ctx, msgIDs)
+	ret0, _ := ret[0].(map[uint64][]models.Reaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReactions indicates an expected call of GetReactions.
+func (mr *MockRepositoryMockRecorder) GetReactions(ctx, msgIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReactions", reflect.TypeOf((*MockRepository)(nil).GetReactions), ctx, msgIDs)
+}
+
+// GetReadCursors mocks base method.
+func (m *MockRepository) GetReadCursors(ctx context.Context, chatID uint64) (map[uint64]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadCursors", ctx, chatID)
+	ret0, _ := ret[0].(map[uint64]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadCursors indicates an expected call of GetReadCursors.
+func (mr *MockRepositoryMockRecorder) GetReadCursors(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadCursors", reflect.TypeOf((*MockRepository)(nil).GetReadCursors), ctx, chatID)
+}
+
+// GetRecentViewers mocks base method.
+func (m *MockRepository) GetRecentViewers(ctx context.Context, userID uint64, since time.Time, limit int) ([]models.ProfileView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentViewers", ctx, userID, since, limit)
+	ret0, _ := ret[0].([]models.ProfileView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentViewers indicates an expected call of GetRecentViewers.
+func (mr *MockRepositoryMockRecorder) GetRecentViewers(ctx, userID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentViewers", reflect.TypeOf((*MockRepository)(nil).GetRecentViewers), ctx, userID, since, limit)
+}
+
+// GetTravelMode mocks base method.
+func (m *MockRepository) GetTravelMode(ctx context.Context, userID uint64) (models.TravelMode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTravelMode", ctx, userID)
+	ret0, _ := ret[0].(models.TravelMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTravelMode indicates an expected call of GetTravelMode.
+func (mr *MockRepositoryMockRecorder) GetTravelMode(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTravelMode", reflect.TypeOf((*MockRepository)(nil).GetTravelMode), ctx, userID)
+}
+
+// GetTravelModes mocks base method.
+func (m *MockRepository) GetTravelModes(ctx context.Context, userIDs []uint64) (map[uint64]models.TravelMode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTravelModes", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]models.TravelMode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTravelModes indicates an expected call of GetTravelModes.
+func (mr *MockRepositoryMockRecorder) GetTravelModes(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTravelModes", reflect.TypeOf((*MockRepository)(nil).GetTravelModes), ctx, userIDs)
+}
+
+// GetUser mocks base method.
+func (m *MockRepository) GetUser(ctx context.Context, userID uint64) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepositoryMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepository)(nil).GetUser), ctx, userID)
+}
+
 // GetUserPhotos mocks base method.
 func (m *MockRepository) GetUserPhotos(ctx context.Context, userID uint64) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +664,21 @@ func (mr *MockRepositoryMockRecorder) GetUserPhotos(ctx, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhotos", reflect.TypeOf((*MockRepository)(nil).GetUserPhotos), ctx, userID)
 }
 
+// GetUsers mocks base method.
+func (m *MockRepository) GetUsers(ctx context.Context, userIDs []uint64) (map[uint64]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, userIDs)
+	ret0, _ := ret[0].(map[uint64]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockRepositoryMockRecorder) GetUsers(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockRepository)(nil).GetUsers), ctx, userIDs)
+}
+
 // GetWhoLikedMe mocks base method.
 func (m *MockRepository) GetWhoLikedMe(ctx context.Context, userID uint64) (uint64, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +694,35 @@ func (mr *MockRepositoryMockRecorder) GetWhoLikedMe(ctx, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWhoLikedMe", reflect.TypeOf((*MockRepository)(nil).GetWhoLikedMe), ctx, userID)
 }
 
+// HideMessage mocks base method.
+func (m *MockRepository) HideMessage(ctx context.Context, msgID, userID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideMessage", ctx, msgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideMessage indicates an expected call of HideMessage.
+func (mr *MockRepositoryMockRecorder) HideMessage(ctx, msgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideMessage", reflect.TypeOf((*MockRepository)(nil).HideMessage), ctx, msgID, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockRepository) MarkRead(ctx context.Context, chatID, userID, messageID uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, chatID, userID, messageID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRepositoryMockRecorder) MarkRead(ctx, chatID, userID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRepository)(nil).MarkRead), ctx, chatID, userID, messageID)
+}
+
 // PutPreferences mocks base method.
 func (m *MockRepository) PutPreferences(ctx context.Context, newPreferences models.Preferences) error {
 	m.ctrl.T.Helper()
@@ -379,6 +751,34 @@ func (mr *MockRepositoryMockRecorder) PutProfile(ctx, newProfile any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutProfile", reflect.TypeOf((*MockRepository)(nil).PutProfile), ctx, newProfile)
 }
 
+// PutReaction mocks base method.
+func (m *MockRepository) PutReaction(ctx context.Context, msgID, userID uint64, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutReaction", ctx, msgID, userID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutReaction indicates an expected call of PutReaction.
+func (mr *MockRepositoryMockRecorder) PutReaction(ctx, msgID, userID, emoji any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutReaction", reflect.TypeOf((*MockRepository)(nil).PutReaction), ctx, msgID, userID, emoji)
+}
+
+// PutTravelMode mocks base method.
+func (m *MockRepository) PutTravelMode(ctx context.Context, mode models.TravelMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutTravelMode", ctx, mode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutTravelMode indicates an expected call of PutTravelMode.
+func (mr *MockRepositoryMockRecorder) PutTravelMode(ctx, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTravelMode", reflect.TypeOf((*MockRepository)(nil).PutTravelMode), ctx, mode)
+}
+
 // ReorderPhotos mocks base method.
 func (m *MockRepository) ReorderPhotos(ctx context.Context, newOrder []string) error {
 	m.ctrl.T.Helper()
@@ -407,19 +807,62 @@ func (mr *MockRepositoryMockRecorder) SaveMessageTranscription(ctx, id, text any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessageTranscription", reflect.TypeOf((*MockRepository)(nil).SaveMessageTranscription), ctx, id, text)
 }
 
+// SearchMessages mocks base method.
+func (m *MockRepository) SearchMessages(ctx context.Context, userID, chatID uint64, terms []string, page models.MessagePage) ([]models.MessageMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", ctx, userID, chatID, terms, page)
+	ret0, _ := ret[0].([]models.MessageMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockRepositoryMockRecorder) SearchMessages(ctx, userID, chatID, terms, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockRepository)(nil).SearchMessages), ctx, userID, chatID, terms, page)
+}
+
 // SendMessage mocks base method.
-func (m *MockRepository) SendMessage(ctx context.Context, chatID, sender uint64, contentType models.MsgContentType, payload string) (models.Message, error) {
+func (m *MockRepository) SendMessage(ctx context.Context, sender uint64, msg models.OutgoingMessage) (models.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendMessage", ctx, chatID, sender, contentType, payload)
+	ret := m.ctrl.Call(m, "SendMessage", ctx, sender, msg)
 	ret0, _ := ret[0].(models.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SendMessage indicates an expected call of SendMessage.
-func (mr *MockRepositoryMockRecorder) SendMessage(ctx, chatID, sender, contentType, payload any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SendMessage(ctx, sender, msg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockRepository)(nil).SendMessage), ctx, chatID, sender, contentType, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockRepository)(nil).SendMessage), ctx, sender, msg)
+}
+
+// SetUserIncognito mocks base method.
+func (m *MockRepository) SetUserIncognito(ctx context.Context, userID uint64, incognito bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserIncognito", ctx, userID, incognito)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserIncognito indicates an expected call of SetUserIncognito.
+func (mr *MockRepositoryMockRecorder) SetUserIncognito(ctx, userID, incognito any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserIncognito", reflect.TypeOf((*MockRepository)(nil).SetUserIncognito), ctx, userID, incognito)
+}
+
+// SetUserShowViewers mocks base method.
+func (m *MockRepository) SetUserShowViewers(ctx context.Context, userID uint64, showViewers bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserShowViewers", ctx, userID, showViewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserShowViewers indicates an expected call of SetUserShowViewers.
+func (mr *MockRepositoryMockRecorder) SetUserShowViewers(ctx, userID, showViewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserShowViewers", reflect.TypeOf((*MockRepository)(nil).SetUserShowViewers), ctx, userID, showViewers)
 }
 
 // MockFileStorage is a mock of FileStorage interface.
@@ -445,6 +888,34 @@ func (m *MockFileStorage) EXPECT() *MockFileStorageMockRecorder {
 	return m.recorder
 }
 
+// DelChatPhoto mocks base method.
+func (m *MockFileStorage) DelChatPhoto(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelChatPhoto", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelChatPhoto indicates an expected call of DelChatPhoto.
+func (mr *MockFileStorageMockRecorder) DelChatPhoto(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelChatPhoto", reflect.TypeOf((*MockFileStorage)(nil).DelChatPhoto), ctx, key)
+}
+
+// DelChatVoice mocks base method.
+func (m *MockFileStorage) DelChatVoice(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DelChatVoice", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DelChatVoice indicates an expected call of DelChatVoice.
+func (mr *MockFileStorageMockRecorder) DelChatVoice(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelChatVoice", reflect.TypeOf((*MockFileStorage)(nil).DelChatVoice), ctx, key)
+}
+
 // DelProfilePhoto mocks base method.
 func (m *MockFileStorage) DelProfilePhoto(ctx context.Context, photoKey string) error {
 	m.ctrl.T.Helper()
@@ -615,6 +1086,62 @@ func (mr *MockUserNotifierMockRecorder) NotifyMatch(ctx, userId, notification an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyMatch", reflect.TypeOf((*MockUserNotifier)(nil).NotifyMatch), ctx, userId, notification)
 }
 
+// NotifyMessageChanged mocks base method.
+func (m *MockUserNotifier) NotifyMessageChanged(ctx context.Context, userId uint64, notification models.MessageChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyMessageChanged", ctx, userId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyMessageChanged indicates an expected call of NotifyMessageChanged.
+func (mr *MockUserNotifierMockRecorder) NotifyMessageChanged(ctx, userId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyMessageChanged", reflect.TypeOf((*MockUserNotifier)(nil).NotifyMessageChanged), ctx, userId, notification)
+}
+
+// NotifyReaction mocks base method.
+func (m *MockUserNotifier) NotifyReaction(ctx context.Context, userId uint64, notification models.ReactionNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyReaction", ctx, userId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyReaction indicates an expected call of NotifyReaction.
+func (mr *MockUserNotifierMockRecorder) NotifyReaction(ctx, userId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyReaction", reflect.TypeOf((*MockUserNotifier)(nil).NotifyReaction), ctx, userId, notification)
+}
+
+// NotifyRead mocks base method.
+func (m *MockUserNotifier) NotifyRead(ctx context.Context, userId uint64, notification models.ReadReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyRead", ctx, userId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyRead indicates an expected call of NotifyRead.
+func (mr *MockUserNotifierMockRecorder) NotifyRead(ctx, userId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyRead", reflect.TypeOf((*MockUserNotifier)(nil).NotifyRead), ctx, userId, notification)
+}
+
+// NotifyTyping mocks base method.
+func (m *MockUserNotifier) NotifyTyping(ctx context.Context, userId uint64, notification models.TypingNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyTyping", ctx, userId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyTyping indicates an expected call of NotifyTyping.
+func (mr *MockUserNotifierMockRecorder) NotifyTyping(ctx, userId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyTyping", reflect.TypeOf((*MockUserNotifier)(nil).NotifyTyping), ctx, userId, notification)
+}
+
 // SendMessage mocks base method.
 func (m *MockUserNotifier) SendMessage(ctx context.Context, userId uint64, notification models.MessageSend) error {
 	m.ctrl.T.Helper()
@@ -679,3 +1206,38 @@ func (mr *MockSttMockRecorder) PutTask(ctx, task any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTask", reflect.TypeOf((*MockStt)(nil).PutTask), ctx, task)
 }
+
+// MockAuditor is a mock of Auditor interface.
+type MockAuditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditorMockRecorder
+}
+
+// MockAuditorMockRecorder is the mock recorder for MockAuditor.
+type MockAuditorMockRecorder struct {
+	mock *MockAuditor
+}
+
+// NewMockAuditor creates a new mock instance.
+func NewMockAuditor(ctrl *gomock.Controller) *MockAuditor {
+	mock := &MockAuditor{ctrl: ctrl}
+	mock.recorder = &MockAuditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditor) EXPECT() *MockAuditorMockRecorder {
+	return m.recorder
+}
+
+// RecordOrLog mocks base method.
+func (m *MockAuditor) RecordOrLog(ctx context.Context, record models.AuditRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordOrLog", ctx, record)
+}
+
+// RecordOrLog indicates an expected call of RecordOrLog.
+func (mr *MockAuditorMockRecorder) RecordOrLog(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOrLog", reflect.TypeOf((*MockAuditor)(nil).RecordOrLog), ctx, record)
+}
@@ -37,6 +37,7 @@ type ServiceTestSuite struct {
 	fsMock           *MockFileStorage
 	userNotifierMock *MockUserNotifier
 	sttMock          *MockStt
+	auditorMock      *MockAuditor
 	service          *Service
 }
 
@@ -50,5 +51,6 @@ func (s *ServiceTestSuite) SetupTest() {
 	s.fsMock = NewMockFileStorage(ctrl)
 	s.userNotifierMock = NewMockUserNotifier(ctrl)
 	s.sttMock = NewMockStt(ctrl)
-	s.service = New(s.repoMock, s.fsMock, s.userNotifierMock, s.sttMock)
+	s.auditorMock = NewMockAuditor(ctrl)
+	s.service = New(s.repoMock, s.fsMock, s.userNotifierMock, s.sttMock, s.auditorMock)
 }
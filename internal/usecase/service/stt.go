@@ -24,6 +24,9 @@ func (s *Service) GetTextFromVoice(ctx context.Context, messageId uint64) (strin
 	if chat.User1 != userId && chat.User2 != userId {
 		return "", false, errPermissionDenied
 	}
+	if !msg.DeletedAt.IsZero() {
+		return "", false, errMessageDeleted
+	}
 	if msg.ContentType != models.ContentVoice {
 		return "", false, &errs.CodableError{
 			Code:    errs.CodeInvalidInput,
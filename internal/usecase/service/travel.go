@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const maxTravelDuration = 30 * 24 * time.Hour
+
+func (s *Service) StartTravelMode(ctx context.Context, mode models.TravelMode) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	mode.UserID = userId
+	now := time.Now()
+	v := errs.NewValidator("bad travel mode")
+	v.Check(mode.LocationLat >= -90 && mode.LocationLat <= 90 && mode.LocationLon >= -180 && mode.LocationLon <= 180,
+		"location", "invalid location")
+	v.Check(mode.LocationName != "", "location_name", "location name required")
+	v.Check(utf8.RuneCountInString(mode.LocationName) <= maxLocationNameLen, "location_name", "location name too long")
+	v.Check(mode.ExpiresAt.After(now) && mode.ExpiresAt.Sub(now) <= maxTravelDuration,
+		"expires_at", "expiry must be within 30 days")
+	if err := v.Err(); err != nil {
+		return err
+	}
+	err := s.repository.PutTravelMode(ctx, mode)
+	if err != nil {
+		return errors.Wrap(err, "can't put travel mode")
+	}
+	return nil
+}
+
+func (s *Service) StopTravelMode(ctx context.Context) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	err := s.repository.DeleteTravelMode(ctx, userId)
+	if err != nil {
+		return errors.Wrap(err, "can't delete travel mode")
+	}
+	return nil
+}
+
+func (s *Service) GetTravelMode(ctx context.Context) (models.TravelMode, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return models.TravelMode{}, errUnauthenticated
+	}
+	return s.getTravelMode(ctx, userId)
+}
+
+func (s *Service) getTravelModes(ctx context.Context, userIDs []uint64) (map[uint64]models.TravelMode, error) {
+	modes, err := s.repository.GetTravelModes(ctx, userIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get travel modes")
+	}
+	now := time.Now()
+	for id, mode := range modes {
+		if !mode.ActiveAt(now) {
+			delete(modes, id)
+		}
+	}
+	return modes, nil
+}
+
+func (s *Service) getTravelMode(ctx context.Context, userID uint64) (models.TravelMode, error) {
+	mode, err := s.repository.GetTravelMode(ctx, userID)
+	if err != nil {
+		return models.TravelMode{}, errors.Wrap(err, "can't get travel mode")
+	}
+	if !mode.ActiveAt(time.Now()) {
+		return models.TravelMode{}, nil
+	}
+	return mode, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	paris = models.TravelMode{
+		LocationLat:  48.85,
+		LocationLon:  2.35,
+		LocationName: "Paris",
+	}
+)
+
+func (s *ServiceTestSuite) TestStartTravelMode() {
+	mode := paris
+	mode.ExpiresAt = time.Now().Add(7 * 24 * time.Hour)
+	expected := mode
+	expected.UserID = userId
+	s.repoMock.EXPECT().PutTravelMode(user1Ctx, expected).Return(nil)
+
+	err := s.service.StartTravelMode(user1Ctx, mode)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestStartTravelMode_InvalidExpiry() {
+	mode := paris
+	mode.ExpiresAt = time.Now().Add(60 * 24 * time.Hour)
+
+	err := s.service.StartTravelMode(user1Ctx, mode)
+
+	s.Equal("bad travel mode: expiry must be within 30 days", err.Error())
+}
+
+func (s *ServiceTestSuite) TestStartTravelMode_LongLocationName() {
+	mode := paris
+	mode.LocationName = strings.Repeat("я", maxLocationNameLen+1)
+	mode.ExpiresAt = time.Now().Add(7 * 24 * time.Hour)
+
+	err := s.service.StartTravelMode(user1Ctx, mode)
+
+	s.Equal("bad travel mode: location name too long", err.Error())
+}
+
+func (s *ServiceTestSuite) TestGetTravelMode_Expired() {
+	mode := paris
+	mode.UserID = userId
+	mode.ExpiresAt = time.Now().Add(-time.Minute)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(mode, nil)
+
+	res, err := s.service.GetTravelMode(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(models.TravelMode{}, res)
+}
+
+func (s *ServiceTestSuite) TestNextPartner_TravelModeDiscoverable() {
+	myTravel := paris
+	myTravel.UserID = userId
+	myTravel.ExpiresAt = time.Now().Add(time.Hour)
+	myTravel.Discoverable = true
+	myProfile := profile
+	myPrefs := models.Preferences{
+		UserID:           userId,
+		LocationLat:      profile.LocationLat,
+		LocationLon:      profile.LocationLon,
+		LocationRadiusKm: 50,
+	}
+	parisian := models.Profile{
+		UserID:       user2Id,
+		LocationLat:  48.86,
+		LocationLon:  2.34,
+		LocationName: "Paris",
+	}
+	s.repoMock.EXPECT().GetProfile(user1Ctx, userId).Return(myProfile, nil).Times(2)
+	s.repoMock.EXPECT().GetPreferences(user1Ctx, userId).Return(myPrefs, nil).Times(2)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, userId).Return(myTravel, nil).Times(2)
+
+	s.repoMock.EXPECT().GetPendingPairAttempts(user1Ctx, userId).Return([]models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetWhoLikedMe(user1Ctx, userId).Return(uint64(0), nil)
+
+	s.repoMock.EXPECT().GetAllValidUsers(user1Ctx).Return([]uint64{user2Id}, nil)
+	s.repoMock.EXPECT().GetHiddenUsers(user1Ctx, userId).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetBoostedUsers(user1Ctx, gomock.Any()).Return([]uint64{}, nil)
+	s.repoMock.EXPECT().GetPhotoCounts(user1Ctx).Return(map[uint64]int{}, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{user2Id: parisian}, nil)
+	s.repoMock.EXPECT().GetPreferencesByUsers(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Preferences{
+		user2Id: {
+			UserID:           user2Id,
+			LocationLat:      parisian.LocationLat,
+			LocationLon:      parisian.LocationLon,
+			LocationRadiusKm: 50,
+		},
+	}, nil)
+	s.repoMock.EXPECT().GetTravelModes(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.TravelMode{}, nil)
+	s.repoMock.EXPECT().GetProfile(user1Ctx, user2Id).Return(parisian, nil)
+	s.repoMock.EXPECT().GetTravelMode(user1Ctx, user2Id).Return(models.TravelMode{}, nil)
+
+	s.repoMock.EXPECT().GetPendingPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().GetLatestPairAttemptByUserPair(user1Ctx, userId, user2Id).Return(models.PairAttempt{}, nil)
+	s.repoMock.EXPECT().CreatePairAttempt(user1Ctx, userId, user2Id).Return(models.PairAttempt{ID: PAID}, nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypePACreated).Return(nil)
+	s.repoMock.EXPECT().CreateEvent(user1Ctx, PAID, models.PETypeSentToUser1).Return(nil)
+	s.repoMock.EXPECT().GetUserPhotos(user1Ctx, user2Id).Return([]string{}, nil)
+	s.repoMock.EXPECT().GetUser(user1Ctx, user2Id).Return(models.User{ID: user2Id}, nil)
+
+	candidate, err := s.service.NextPartner(user1Ctx)
+
+	s.Nil(err)
+	s.Equal(user2Id, candidate.Profile.UserID)
+	s.Equal(2, candidate.DistanceKm)
+}
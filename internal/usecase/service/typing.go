@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	typingThrottle = 3 * time.Second
+	// typingExpiry drops typing state of users that never said they stopped,
+	// e.g. because their connection went away.
+	typingExpiry = 30 * time.Second
+)
+
+type typingKey struct {
+	userId uint64
+	chatId uint64
+}
+
+func (s *Service) SetTyping(ctx context.Context, chatId uint64, typing bool) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	chat, err := s.repository.GetChat(ctx, chatId)
+	if err != nil {
+		return errors.Wrap(err, "can't get chat")
+	}
+	if chat.User1 != userId && chat.User2 != userId {
+		return errPermissionDenied
+	}
+	if !s.shouldRelayTyping(typingKey{userId: userId, chatId: chatId}, typing, time.Now()) {
+		return nil
+	}
+	err = s.userNotifier.NotifyTyping(ctx, getWhoIsNotMe(chat.User1, chat.User2, userId), models.TypingNotification{
+		ChatID: chatId,
+		Typing: typing,
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't notify typing")
+	}
+	return nil
+}
+
+func (s *Service) shouldRelayTyping(key typingKey, typing bool, now time.Time) bool {
+	s.typingMu.Lock()
+	defer s.typingMu.Unlock()
+	s.expireTyping(now)
+	last, started := s.typingSent[key]
+	if !typing {
+		delete(s.typingSent, key)
+		return started
+	}
+	if started && now.Sub(last) < typingThrottle {
+		return false
+	}
+	s.typingSent[key] = now
+	return true
+}
+
+func (s *Service) expireTyping(now time.Time) {
+	if now.Sub(s.typingExpiredAt) < typingExpiry {
+		return
+	}
+	for key, last := range s.typingSent {
+		if now.Sub(last) >= typingExpiry {
+			delete(s.typingSent, key)
+		}
+	}
+	s.typingExpiredAt = now
+}
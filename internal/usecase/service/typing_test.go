@@ -0,0 +1,69 @@
+package service
+
+import (
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/models"
+)
+
+func (s *ServiceTestSuite) TestSetTyping_Throttles() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil).Times(4)
+	s.userNotifierMock.EXPECT().NotifyTyping(user1Ctx, user2Id, models.TypingNotification{
+		ChatID: chat.ID,
+		Typing: true,
+	}).Return(nil).Times(2)
+	s.userNotifierMock.EXPECT().NotifyTyping(user1Ctx, user2Id, models.TypingNotification{
+		ChatID: chat.ID,
+	}).Return(nil)
+
+	s.Nil(s.service.SetTyping(user1Ctx, chat.ID, true))
+	s.Nil(s.service.SetTyping(user1Ctx, chat.ID, true))
+	s.Nil(s.service.SetTyping(user1Ctx, chat.ID, false))
+	s.Nil(s.service.SetTyping(user1Ctx, chat.ID, true))
+}
+
+func (s *ServiceTestSuite) TestSetTyping_StoppedWithoutStarted() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+
+	err := s.service.SetTyping(user1Ctx, chat.ID, false)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestSetTyping_RelaysAgainAfterThrottle() {
+	key := typingKey{userId: userId, chatId: chat.ID}
+	s.service.typingSent[key] = time.Now().Add(-typingThrottle)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.userNotifierMock.EXPECT().NotifyTyping(user1Ctx, user2Id, models.TypingNotification{
+		ChatID: chat.ID,
+		Typing: true,
+	}).Return(nil)
+
+	err := s.service.SetTyping(user1Ctx, chat.ID, true)
+
+	s.Nil(err)
+}
+
+func (s *ServiceTestSuite) TestSetTyping_ExpiresStaleEntries() {
+	stale := typingKey{userId: 200, chatId: 300}
+	s.service.typingSent[stale] = time.Now().Add(-typingExpiry)
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(chat, nil)
+	s.userNotifierMock.EXPECT().NotifyTyping(user1Ctx, user2Id, models.TypingNotification{
+		ChatID: chat.ID,
+		Typing: true,
+	}).Return(nil)
+
+	err := s.service.SetTyping(user1Ctx, chat.ID, true)
+
+	s.Nil(err)
+	s.NotContains(s.service.typingSent, stale)
+	s.Contains(s.service.typingSent, typingKey{userId: userId, chatId: chat.ID})
+}
+
+func (s *ServiceTestSuite) TestSetTyping_NotParticipant() {
+	s.repoMock.EXPECT().GetChat(user1Ctx, chat.ID).Return(models.Chat{ID: chat.ID, User1: 200, User2: 201}, nil)
+
+	err := s.service.SetTyping(user1Ctx, chat.ID, true)
+
+	s.Equal(errPermissionDenied, err)
+}
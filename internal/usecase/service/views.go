@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/errs"
+	"github.com/mayye4ka/pinder/internal/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxStatsDays      = 90
+	recentViewersFor  = 30 * 24 * time.Hour
+	recentViewersSize = 50
+)
+
+func (s *Service) GetProfileViewStats(ctx context.Context, days int) (models.ProfileViewStats, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return models.ProfileViewStats{}, errUnauthenticated
+	}
+	if days < 1 || days > maxStatsDays {
+		return models.ProfileViewStats{}, errs.InvalidField("bad stats period", "days", "must be between 1 and 90 days")
+	}
+	from := time.Now().AddDate(0, 0, 1-days)
+	daily, err := s.repository.GetDailyProfileStats(ctx, userId, from)
+	if err != nil {
+		return models.ProfileViewStats{}, errors.Wrap(err, "can't get daily profile stats")
+	}
+	stats := models.ProfileViewStats{Days: daily}
+	for _, day := range daily {
+		stats.Total.Views += day.Views
+		stats.Total.Likes += day.Likes
+	}
+	return stats, nil
+}
+
+func (s *Service) SetShowViewers(ctx context.Context, showViewers bool) error {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return errUnauthenticated
+	}
+	err := s.repository.SetUserShowViewers(ctx, userId, showViewers)
+	if err != nil {
+		return errors.Wrap(err, "can't set show viewers")
+	}
+	return nil
+}
+
+func (s *Service) GetRecentViewers(ctx context.Context) ([]models.ProfileViewer, error) {
+	userId := ctx.Value(userIdContextKey).(uint64)
+	if userId == 0 {
+		return nil, errUnauthenticated
+	}
+	user, err := s.repository.GetUser(ctx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get user")
+	}
+	if !user.ShowViewers {
+		return nil, &errs.CodableError{
+			Code:    errs.CodeFailedPrecondition,
+			Message: "recent viewers are disabled",
+			Reason:  errs.ReasonViewersDisabled,
+		}
+	}
+	views, err := s.repository.GetRecentViewers(ctx, userId, time.Now().Add(-recentViewersFor), recentViewersSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get recent viewers")
+	}
+	viewerIds := make([]uint64, len(views))
+	for i, view := range views {
+		viewerIds[i] = view.ViewerID
+	}
+	profiles, err := s.repository.GetProfiles(ctx, viewerIds)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get profiles")
+	}
+	photos, err := s.repository.GetFirstPhotos(ctx, viewerIds)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get user photos")
+	}
+	viewers := make([]models.ProfileViewer, 0, len(views))
+	for _, view := range views {
+		viewer := models.ProfileViewer{
+			UserID:   view.ViewerID,
+			Name:     profiles[view.ViewerID].Name,
+			ViewedAt: view.ViewedAt,
+		}
+		if photo, ok := photos[view.ViewerID]; ok {
+			viewer.Photo, err = s.filestorage.MakeProfilePhotoLink(ctx, photo)
+			if err != nil {
+				return nil, errors.Wrap(err, "can't make profile photo link")
+			}
+		}
+		viewers = append(viewers, viewer)
+	}
+	return viewers, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"time"
+
+	"github.com/mayye4ka/pinder/internal/models"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *ServiceTestSuite) TestGetProfileViewStats() {
+	yesterday := time.Now().AddDate(0, 0, -1)
+	daily := []models.DailyProfileStats{
+		{Day: yesterday, ProfileStats: models.ProfileStats{Views: 10, Likes: 2}},
+		{Day: time.Now(), ProfileStats: models.ProfileStats{Views: 6, Likes: 2}},
+	}
+	s.repoMock.EXPECT().GetDailyProfileStats(user1Ctx, userId, gomock.Any()).Return(daily, nil)
+
+	stats, err := s.service.GetProfileViewStats(user1Ctx, 7)
+
+	s.Nil(err)
+	s.Equal(daily, stats.Days)
+	s.Equal(models.ProfileStats{Views: 16, Likes: 4}, stats.Total)
+	s.Equal(0.25, stats.Total.LikeRate())
+}
+
+func (s *ServiceTestSuite) TestGetProfileViewStats_InvalidPeriod() {
+	_, err := s.service.GetProfileViewStats(user1Ctx, 365)
+
+	s.Equal("bad stats period: must be between 1 and 90 days", err.Error())
+}
+
+func (s *ServiceTestSuite) TestGetRecentViewers() {
+	viewedAt := time.Now().Add(-time.Hour)
+	s.repoMock.EXPECT().GetUser(user1Ctx, userId).Return(models.User{ID: userId, ShowViewers: true}, nil)
+	s.repoMock.EXPECT().GetRecentViewers(user1Ctx, userId, gomock.Any(), recentViewersSize).Return([]models.ProfileView{
+		{ViewerID: user2Id, ViewedAt: viewedAt},
+	}, nil)
+	s.repoMock.EXPECT().GetProfiles(user1Ctx, []uint64{user2Id}).Return(map[uint64]models.Profile{
+		user2Id: {UserID: user2Id, Name: userName},
+	}, nil)
+	s.repoMock.EXPECT().GetFirstPhotos(user1Ctx, []uint64{user2Id}).Return(map[uint64]string{user2Id: photo1}, nil)
+	s.fsMock.EXPECT().MakeProfilePhotoLink(user1Ctx, photo1).Return(photo1Link, nil)
+
+	viewers, err := s.service.GetRecentViewers(user1Ctx)
+
+	s.Nil(err)
+	s.Equal([]models.ProfileViewer{{
+		UserID:   user2Id,
+		Name:     userName,
+		Photo:    photo1Link,
+		ViewedAt: viewedAt,
+	}}, viewers)
+}
+
+func (s *ServiceTestSuite) TestGetRecentViewers_Disabled() {
+	s.repoMock.EXPECT().GetUser(user1Ctx, userId).Return(models.User{ID: userId}, nil)
+
+	_, err := s.service.GetRecentViewers(user1Ctx)
+
+	s.Equal("recent viewers are disabled", err.Error())
+}
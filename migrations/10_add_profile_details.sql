@@ -0,0 +1,37 @@
+-- +migrate Up
+CREATE TABLE profile_details(
+    user_id int NOT NULL,
+    height_cm int NOT NULL DEFAULT 0,
+    relationship_goal varchar(40) NOT NULL DEFAULT '',
+    smoking varchar(40) NOT NULL DEFAULT '',
+    drinking varchar(40) NOT NULL DEFAULT '',
+    children varchar(40) NOT NULL DEFAULT '',
+    PRIMARY KEY(user_id)
+);
+CREATE TABLE interests(
+    tag varchar(40) NOT NULL,
+    PRIMARY KEY(tag)
+);
+CREATE TABLE profile_interests(
+    user_id int NOT NULL,
+    tag varchar(40) NOT NULL,
+    PRIMARY KEY(user_id, tag),
+    KEY(tag)
+);
+CREATE TABLE profile_languages(
+    user_id int NOT NULL,
+    language varchar(8) NOT NULL,
+    PRIMARY KEY(user_id, language)
+);
+INSERT INTO interests(tag) VALUES
+    ('art'), ('board_games'), ('books'), ('cinema'), ('coffee'), ('cooking'),
+    ('cycling'), ('dancing'), ('fashion'), ('fitness'), ('gaming'), ('hiking'),
+    ('languages'), ('music'), ('nature'), ('pets'), ('photography'), ('politics'),
+    ('running'), ('science'), ('skiing'), ('sports'), ('swimming'), ('technology'),
+    ('theatre'), ('travel'), ('volunteering'), ('wine'), ('yoga');
+
+-- +migrate Down
+DROP TABLE profile_details;
+DROP TABLE interests;
+DROP TABLE profile_interests;
+DROP TABLE profile_languages;
@@ -0,0 +1,20 @@
+-- +migrate Up
+ALTER TABLE preferences ADD COLUMN min_height_cm int NOT NULL DEFAULT 0;
+ALTER TABLE preferences ADD COLUMN max_height_cm int NOT NULL DEFAULT 0;
+CREATE TABLE preference_values(
+    user_id int NOT NULL,
+    criterion varchar(40) NOT NULL,
+    value varchar(40) NOT NULL,
+    PRIMARY KEY(user_id, criterion, value)
+);
+CREATE TABLE preference_dealbreakers(
+    user_id int NOT NULL,
+    criterion varchar(40) NOT NULL,
+    PRIMARY KEY(user_id, criterion)
+);
+
+-- +migrate Down
+ALTER TABLE preferences DROP COLUMN min_height_cm;
+ALTER TABLE preferences DROP COLUMN max_height_cm;
+DROP TABLE preference_values;
+DROP TABLE preference_dealbreakers;
@@ -0,0 +1,19 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN custom_gender varchar(40) NOT NULL DEFAULT '' AFTER gender;
+CREATE TABLE preference_genders(
+    user_id int NOT NULL,
+    gender varchar(40) NOT NULL,
+    PRIMARY KEY(user_id, gender)
+);
+INSERT INTO preference_genders(user_id, gender)
+    SELECT user_id, gender FROM preferences WHERE gender IN ('male', 'female');
+ALTER TABLE preferences DROP COLUMN gender;
+
+-- +migrate Down
+ALTER TABLE preferences ADD COLUMN gender varchar(40) NOT NULL DEFAULT '' AFTER user_id;
+UPDATE preferences p
+    JOIN (SELECT user_id, MIN(gender) AS gender FROM preference_genders GROUP BY user_id) g
+    ON g.user_id = p.user_id
+    SET p.gender = g.gender;
+DROP TABLE preference_genders;
+ALTER TABLE profiles DROP COLUMN custom_gender;
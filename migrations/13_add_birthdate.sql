@@ -0,0 +1,14 @@
+-- +migrate Up
+ALTER TABLE profiles ADD COLUMN birthdate date NULL AFTER custom_gender;
+-- profiles carry no timestamp of their own; the last update_profile audit
+-- entry is when the row was last written.
+UPDATE profiles p SET p.birthdate = DATE_SUB(DATE(COALESCE(
+    (SELECT MAX(a.created_at) FROM audit_log a WHERE a.action = 'update_profile' AND a.target_id = p.user_id),
+    NOW()
+)), INTERVAL p.age YEAR);
+ALTER TABLE profiles DROP COLUMN age;
+
+-- +migrate Down
+ALTER TABLE profiles ADD COLUMN age int NOT NULL DEFAULT 0 AFTER custom_gender;
+UPDATE profiles SET age = TIMESTAMPDIFF(YEAR, birthdate, CURDATE()) WHERE birthdate IS NOT NULL;
+ALTER TABLE profiles DROP COLUMN birthdate;
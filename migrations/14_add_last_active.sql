@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN last_active_at datetime NULL AFTER token_version;
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN last_active_at;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE travel_modes(
+    user_id int NOT NULL,
+    location_lat double NOT NULL,
+    location_lon double NOT NULL,
+    location_name varchar(40) NOT NULL,
+    discoverable bool NOT NULL DEFAULT false,
+    expires_at datetime NOT NULL,
+    PRIMARY KEY(user_id),
+    KEY(expires_at)
+);
+
+-- +migrate Down
+DROP TABLE travel_modes;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN incognito bool NOT NULL DEFAULT false AFTER banned;
+CREATE INDEX pair_events_pa_id_event_type ON pair_events(pa_id, event_type);
+
+-- +migrate Down
+DROP INDEX pair_events_pa_id_event_type ON pair_events;
+ALTER TABLE users DROP COLUMN incognito;
@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE boosts(
+    id int NOT NULL AUTO_INCREMENT,
+    user_id int NOT NULL,
+    started_at datetime NOT NULL,
+    ends_at datetime NOT NULL,
+    reported bool NOT NULL DEFAULT false,
+    views int NOT NULL DEFAULT 0,
+    likes int NOT NULL DEFAULT 0,
+    baseline_views int NOT NULL DEFAULT 0,
+    baseline_likes int NOT NULL DEFAULT 0,
+    PRIMARY KEY(id),
+    KEY(user_id, started_at),
+    KEY(ends_at, reported)
+);
+
+-- +migrate Down
+DROP TABLE boosts;
@@ -0,0 +1,44 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN show_viewers bool NOT NULL DEFAULT false AFTER incognito;
+
+CREATE TABLE profile_view_stats(
+    user_id int NOT NULL,
+    day date NOT NULL,
+    views int NOT NULL DEFAULT 0,
+    likes int NOT NULL DEFAULT 0,
+    PRIMARY KEY(user_id, day)
+);
+
+CREATE TABLE profile_viewers(
+    viewed_id int NOT NULL,
+    viewer_id int NOT NULL,
+    viewed_at datetime NOT NULL,
+    PRIMARY KEY(viewed_id, viewer_id),
+    KEY(viewed_id, viewed_at)
+);
+
+INSERT INTO profile_view_stats(user_id, day, views, likes)
+SELECT
+    CASE WHEN pe.event_type IN ('sent_to_user_1', 'user_1_liked') THEN pa.user2 ELSE pa.user1 END,
+    DATE(pe.created_at),
+    SUM(pe.event_type IN ('sent_to_user_1', 'sent_to_user_2')),
+    SUM(pe.event_type IN ('user_1_liked', 'user_2_liked'))
+FROM pair_events pe
+JOIN pair_attempts pa ON pa.id = pe.pa_id
+WHERE pe.event_type IN ('sent_to_user_1', 'sent_to_user_2', 'user_1_liked', 'user_2_liked')
+GROUP BY 1, 2;
+
+INSERT INTO profile_viewers(viewed_id, viewer_id, viewed_at)
+SELECT
+    CASE WHEN pe.event_type = 'sent_to_user_1' THEN pa.user2 ELSE pa.user1 END,
+    CASE WHEN pe.event_type = 'sent_to_user_1' THEN pa.user1 ELSE pa.user2 END,
+    MAX(pe.created_at)
+FROM pair_events pe
+JOIN pair_attempts pa ON pa.id = pe.pa_id
+WHERE pe.event_type IN ('sent_to_user_1', 'sent_to_user_2')
+GROUP BY 1, 2;
+
+-- +migrate Down
+DROP TABLE profile_viewers;
+DROP TABLE profile_view_stats;
+ALTER TABLE users DROP COLUMN show_viewers;
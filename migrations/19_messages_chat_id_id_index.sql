@@ -0,0 +1,7 @@
+-- +migrate Up
+CREATE INDEX messages_chat_id_id ON messages(chat_id, id);
+DROP INDEX chat_id ON messages;
+
+-- +migrate Down
+CREATE INDEX chat_id ON messages(chat_id);
+DROP INDEX messages_chat_id_id ON messages;
@@ -0,0 +1,30 @@
+-- +migrate Up
+ALTER TABLE chats
+    ADD COLUMN last_message_id int NULL,
+    ADD COLUMN last_activity_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE chats c
+JOIN (SELECT chat_id, MAX(id) AS last_id, MAX(created_at) AS last_at FROM messages GROUP BY chat_id) m ON m.chat_id = c.id
+SET c.last_message_id = m.last_id, c.last_activity_at = m.last_at;
+
+CREATE INDEX chats_user1_activity ON chats(user1, last_activity_at, id);
+CREATE INDEX chats_user2_activity ON chats(user2, last_activity_at, id);
+
+CREATE TABLE chat_read_cursors(
+    chat_id int NOT NULL,
+    user_id int NOT NULL,
+    message_id int NOT NULL,
+    updated_at datetime NOT NULL,
+    PRIMARY KEY(chat_id, user_id)
+);
+
+INSERT INTO chat_read_cursors(chat_id, user_id, message_id, updated_at)
+SELECT id, user1, last_message_id, NOW() FROM chats WHERE last_message_id IS NOT NULL
+UNION ALL
+SELECT id, user2, last_message_id, NOW() FROM chats WHERE last_message_id IS NOT NULL;
+
+-- +migrate Down
+DROP TABLE chat_read_cursors;
+DROP INDEX chats_user1_activity ON chats;
+DROP INDEX chats_user2_activity ON chats;
+ALTER TABLE chats DROP COLUMN last_message_id, DROP COLUMN last_activity_at;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE messages ADD COLUMN client_message_id varchar(64) NULL;
+CREATE UNIQUE INDEX messages_sender_client_id ON messages(sender_id, client_message_id);
+
+-- +migrate Down
+DROP INDEX messages_sender_client_id ON messages;
+ALTER TABLE messages DROP COLUMN client_message_id;
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE messages
+    ADD COLUMN edited_at datetime NULL,
+    ADD COLUMN deleted_at datetime NULL;
+
+CREATE TABLE message_edits(
+    id int NOT NULL AUTO_INCREMENT,
+    message_id int NOT NULL,
+    payload text NOT NULL,
+    edited_at datetime NOT NULL,
+    PRIMARY KEY(id),
+    KEY(message_id)
+);
+
+CREATE TABLE message_hides(
+    message_id int NOT NULL,
+    user_id int NOT NULL,
+    hidden_at datetime NOT NULL,
+    PRIMARY KEY(user_id, message_id)
+);
+
+-- +migrate Down
+DROP TABLE message_hides;
+DROP TABLE message_edits;
+ALTER TABLE messages DROP COLUMN edited_at, DROP COLUMN deleted_at;
@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE message_reactions(
+    message_id int NOT NULL,
+    user_id int NOT NULL,
+    emoji varchar(16) NOT NULL,
+    created_at datetime NOT NULL,
+    PRIMARY KEY(message_id, user_id)
+);
+
+-- +migrate Down
+DROP TABLE message_reactions;
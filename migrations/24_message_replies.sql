@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE messages ADD COLUMN reply_to_id int NULL;
+
+-- +migrate Down
+ALTER TABLE messages DROP COLUMN reply_to_id;
@@ -0,0 +1,7 @@
+-- +migrate Up
+CREATE FULLTEXT INDEX messages_payload_ft ON messages(payload);
+CREATE FULLTEXT INDEX transcriptions_transcription_ft ON transcriptions(transcription);
+
+-- +migrate Down
+DROP INDEX transcriptions_transcription_ft ON transcriptions;
+DROP INDEX messages_payload_ft ON messages;
@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE notification_sequences(
+    user_id int NOT NULL,
+    seq bigint NOT NULL,
+    PRIMARY KEY(user_id)
+);
+
+CREATE TABLE notifications(
+    user_id int NOT NULL,
+    seq bigint NOT NULL,
+    payload blob NOT NULL,
+    created_at datetime NOT NULL,
+    PRIMARY KEY(user_id, seq),
+    KEY(created_at)
+);
+
+-- +migrate Down
+DROP TABLE notifications;
+DROP TABLE notification_sequences;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE notifications ADD COLUMN kind varchar(16) NOT NULL DEFAULT 'data' AFTER seq;
+
+-- +migrate Down
+ALTER TABLE notifications DROP COLUMN kind;
@@ -0,0 +1,21 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN role varchar(40) NOT NULL DEFAULT 'user' AFTER pass_hash;
+ALTER TABLE users ADD COLUMN banned bool NOT NULL DEFAULT false AFTER role;
+ALTER TABLE users ADD COLUMN token_version int NOT NULL DEFAULT 0 AFTER banned;
+CREATE TABLE audit_log(
+    id int NOT NULL AUTO_INCREMENT,
+    actor_id int NOT NULL,
+    action varchar(80) NOT NULL,
+    target_id int NOT NULL,
+    details text NOT NULL,
+    created_at datetime NOT NULL,
+    PRIMARY KEY(id),
+    KEY(actor_id),
+    KEY(target_id)
+);
+
+-- +migrate Down
+ALTER TABLE users DROP COLUMN role;
+ALTER TABLE users DROP COLUMN banned;
+ALTER TABLE users DROP COLUMN token_version;
+DROP TABLE audit_log;
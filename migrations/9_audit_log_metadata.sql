@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE audit_log ADD COLUMN ip varchar(64) NOT NULL DEFAULT '' AFTER details;
+ALTER TABLE audit_log ADD COLUMN user_agent varchar(255) NOT NULL DEFAULT '' AFTER ip;
+ALTER TABLE audit_log ADD KEY(action);
+ALTER TABLE audit_log ADD KEY(created_at);
+
+-- +migrate StatementBegin
+CREATE TRIGGER audit_log_no_update BEFORE UPDATE ON audit_log
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only';
+-- +migrate StatementEnd
+
+-- +migrate StatementBegin
+CREATE TRIGGER audit_log_no_delete BEFORE DELETE ON audit_log
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_log is append-only';
+-- +migrate StatementEnd
+
+-- +migrate Down
+DROP TRIGGER audit_log_no_update;
+DROP TRIGGER audit_log_no_delete;
+ALTER TABLE audit_log DROP KEY action;
+ALTER TABLE audit_log DROP KEY created_at;
+ALTER TABLE audit_log DROP COLUMN ip;
+ALTER TABLE audit_log DROP COLUMN user_agent;